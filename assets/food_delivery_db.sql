@@ -2,17 +2,17 @@ CREATE DATABASE food_delivery_db;
 
 CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
 
-CREATE TYPE role AS ENUM ('admin', 'employee', 'customer');
+CREATE TYPE role AS ENUM ('admin', 'employee', 'customer', 'courier');
 
 CREATE TYPE gender AS ENUM ('male', 'female');
 
 CREATE TYPE transaction_type AS ENUM ('credit', 'debit'); 
 
-CREATE TYPE menu_type AS ENUM ('main dish', 'side dish', 'dessert', 'beverage');
+CREATE TYPE zone_type AS ENUM ('radius', 'polygon');
 
-CREATE TYPE unit_type AS ENUM ('piece', 'portion', 'packet', 'cup')
+CREATE TYPE stock_movement_type AS ENUM ('receipt', 'adjustment', 'consumption');
 
-CREATE TYPE order_status AS ENUM ('preparing', 'out for delivery', 'delivered');
+CREATE TYPE order_status AS ENUM ('scheduled', 'confirmed', 'preparing', 'ready for pickup', 'out for delivery', 'delivery failed', 'delivered', 'cancelled');
 
 CREATE TABLE users(
   id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
@@ -34,21 +34,138 @@ CREATE TABLE token_blacklists (
   expires_at TIMESTAMP NOT NULL
 );
 
+-- A single row of restaurant-wide settings, such as the manual pause of ordering.
+create table restaurant_settings(
+  id BOOLEAN PRIMARY KEY DEFAULT TRUE,
+  ordering_paused BOOLEAN NOT NULL DEFAULT FALSE,
+  pause_reason TEXT,
+  updated_by uuid,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (updated_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+ALTER TABLE restaurant_settings ADD CONSTRAINT single_restaurant_settings CHECK (id);
+
+INSERT INTO restaurant_settings DEFAULT VALUES ON CONFLICT DO NOTHING;
+
+-- Weekly opening hours in the restaurant's timezone; day_of_week runs from 0 (Sunday) to 6 (Saturday),
+-- and a close_time at or before open_time runs past midnight.
+create table opening_hours(
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  day_of_week INT NOT NULL,
+  open_time TIME NOT NULL,
+  close_time TIME NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE opening_hours ADD CONSTRAINT opening_hour_day_of_week CHECK (day_of_week BETWEEN 0 AND 6);
+ALTER TABLE opening_hours ADD CONSTRAINT unique_opening_hour UNIQUE (day_of_week, open_time);
+
+-- Dated exceptions to the weekly hours: closed all day, or open with holiday hours.
+create table restaurant_closures(
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  closure_date DATE NOT NULL,
+  is_closed BOOLEAN NOT NULL DEFAULT TRUE,
+  open_time TIME,
+  close_time TIME,
+  reason TEXT,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE restaurant_closures ADD CONSTRAINT unique_closure_date UNIQUE (closure_date);
+ALTER TABLE restaurant_closures ADD CONSTRAINT closure_hours CHECK (is_closed OR (open_time IS NOT NULL AND close_time IS NOT NULL));
+
+-- A stored request per Idempotency-Key; status_code stays NULL while the first request is still running.
+create table idempotency_keys(
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  user_id uuid NOT NULL,
+  idempotency_key VARCHAR(255) NOT NULL,
+  request_hash VARCHAR(64) NOT NULL,
+  status_code INT,
+  response_body BYTEA,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+ALTER TABLE idempotency_keys ADD CONSTRAINT unique_idempotency_key UNIQUE (user_id, idempotency_key);
+
+create table menu_categories(
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  name VARCHAR(100) NOT NULL,
+  description TEXT,
+  display_order INT NOT NULL DEFAULT 0,
+  is_active BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP
+);
+
+ALTER TABLE menu_categories ADD CONSTRAINT unique_menu_category_name UNIQUE (name);
+
+INSERT INTO menu_categories(name, display_order) VALUES ('main dish', 1), ('side dish', 2), ('dessert', 3), ('beverage', 4);
+
+create table unit_types(
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  name VARCHAR(100) NOT NULL,
+  description TEXT,
+  display_order INT NOT NULL DEFAULT 0,
+  is_active BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP
+);
+
+ALTER TABLE unit_types ADD CONSTRAINT unique_unit_type_name UNIQUE (name);
+
+INSERT INTO unit_types(name, display_order) VALUES ('piece', 1), ('portion', 2), ('packet', 3), ('cup', 4);
+
 create table menus(
   id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
   name VARCHAR(255) NOT NULL,
-  type menu_type NOT NULL,
+  category_id uuid NOT NULL,
   description TEXT NOT NULL,
-  unit_type unit_type NOT NULL,
+  unit_type_id uuid NOT NULL,
   price DOUBLE PRECISION NOT NULL,
+  preparation_time INT NOT NULL DEFAULT 0,
+  is_available BOOLEAN NOT NULL DEFAULT TRUE,
+  daily_stock INT,
+  stock_left INT,
   created_by uuid NOT NULL,
   created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
   updated_at TIMESTAMP,
+  archived_at TIMESTAMP,
+  image_key VARCHAR(255),
+  FOREIGN KEY (category_id) REFERENCES menu_categories(id) ON DELETE RESTRICT,
+  FOREIGN KEY (unit_type_id) REFERENCES unit_types(id) ON DELETE RESTRICT,
   FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE
 );
 
-ALTER TABLE menus ADD CONSTRAINT unique_menu_name UNIQUE (name);
-ALTER TABLE menus ADD CONSTRAINT unique_menu_description UNIQUE (description);
+-- Archived menus give up their name and description, so a replacement dish can reuse them.
+CREATE UNIQUE INDEX unique_menu_name ON menus (name) WHERE archived_at IS NULL;
+CREATE UNIQUE INDEX unique_menu_description ON menus (description) WHERE archived_at IS NULL;
+ALTER TABLE menus ADD CONSTRAINT menu_preparation_time_positive CHECK (preparation_time >= 0);
+ALTER TABLE menus ADD CONSTRAINT menu_stock_positive CHECK (daily_stock >= 0 AND stock_left >= 0);
+
+create table menu_modifier_groups(
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  menu_id uuid NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  is_required BOOLEAN NOT NULL DEFAULT FALSE,
+  min_select INT NOT NULL DEFAULT 0,
+  max_select INT NOT NULL DEFAULT 1,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (menu_id) REFERENCES menus(id) ON DELETE CASCADE
+);
+
+ALTER TABLE menu_modifier_groups ADD CONSTRAINT unique_modifier_group_name UNIQUE (menu_id, name);
+ALTER TABLE menu_modifier_groups ADD CONSTRAINT modifier_group_select_range CHECK (min_select >= 0 AND max_select >= min_select AND max_select > 0);
+
+create table menu_modifier_options(
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  group_id uuid NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  price_delta DOUBLE PRECISION NOT NULL DEFAULT 0,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (group_id) REFERENCES menu_modifier_groups(id) ON DELETE CASCADE
+);
 
 create table balances(
   id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
@@ -61,6 +178,22 @@ create table balances(
   FOREIGN KEY (customer_id) REFERENCES users(id) ON DELETE CASCADE
 );
 
+ALTER TABLE balances ADD CONSTRAINT balance_non_negative CHECK (balance >= 0);
+
+create table wallets(
+  customer_id uuid PRIMARY KEY,
+  balance DOUBLE PRECISION NOT NULL DEFAULT 0,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (customer_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+ALTER TABLE wallets ADD CONSTRAINT wallet_balance_non_negative CHECK (balance >= 0);
+
+-- Seed wallets from the latest running balance of each customer
+INSERT INTO wallets(customer_id, balance)
+SELECT DISTINCT ON (customer_id) customer_id, balance FROM balances ORDER BY customer_id, created_at DESC
+ON CONFLICT (customer_id) DO NOTHING;
+
 create table promos(
   id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
   employee_id uuid,
@@ -73,29 +206,142 @@ create table promos(
   FOREIGN KEY (employee_id) REFERENCES users(id) ON DELETE CASCADE
 );
 
+create table customer_addresses(
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  customer_id uuid NOT NULL,
+  label VARCHAR(50),
+  address TEXT NOT NULL,
+  latitude DOUBLE PRECISION NOT NULL,
+  longitude DOUBLE PRECISION NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP,
+  FOREIGN KEY (customer_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+ALTER TABLE customer_addresses ADD CONSTRAINT customer_address_coordinates CHECK (latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180);
+
+-- A radius zone covers every point within radius_km of the restaurant,
+-- a polygon zone covers the area inside polygon, a JSON array of [latitude, longitude] points.
+create table delivery_zones(
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  zone_type zone_type NOT NULL,
+  radius_km DOUBLE PRECISION,
+  polygon JSONB,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE delivery_zones ADD CONSTRAINT unique_delivery_zone_name UNIQUE (name);
+
+-- Fee table of a zone: the fee of the first tier whose max_distance_km covers the delivery distance applies.
+create table delivery_zone_fees(
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  zone_id uuid NOT NULL,
+  max_distance_km DOUBLE PRECISION NOT NULL,
+  fee DOUBLE PRECISION NOT NULL,
+  FOREIGN KEY (zone_id) REFERENCES delivery_zones(id) ON DELETE CASCADE
+);
+
+ALTER TABLE delivery_zone_fees ADD CONSTRAINT unique_delivery_zone_fee UNIQUE (zone_id, max_distance_km);
+ALTER TABLE delivery_zone_fees ADD CONSTRAINT delivery_zone_fee_non_negative CHECK (fee >= 0 AND max_distance_km > 0);
+
 create table orders(
   id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
   customer_id uuid NOT NULL,
+  address_id uuid,
   address TEXT NOT NULL,
   promo_code VARCHAR(255),
   promo_used BOOLEAN DEFAULT FALSE,
   order_status order_status NOT NULL,
   note TEXT,
   date DATE,
+  scheduled_at TIMESTAMPTZ,
+  subtotal DOUBLE PRECISION NOT NULL DEFAULT 0,
+  discount_amount DOUBLE PRECISION NOT NULL DEFAULT 0,
+  delivery_distance DOUBLE PRECISION NOT NULL DEFAULT 0,
+  delivery_fee DOUBLE PRECISION NOT NULL DEFAULT 0,
+  service_charge DOUBLE PRECISION NOT NULL DEFAULT 0,
+  tax_amount DOUBLE PRECISION NOT NULL DEFAULT 0,
+  tax_inclusive BOOLEAN NOT NULL DEFAULT FALSE,
   total_price DOUBLE PRECISION NOT NULL,
+  tip_amount DOUBLE PRECISION NOT NULL DEFAULT 0,
+  cancel_reason TEXT,
+  courier_id uuid,
   created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (customer_id) REFERENCES users(id) ON DELETE CASCADE,
+  FOREIGN KEY (address_id) REFERENCES customer_addresses(id) ON DELETE SET NULL,
+  FOREIGN KEY (courier_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- Every tip is its own row, so tips can be reported by the time they were given.
+create table order_tips(
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  order_id uuid NOT NULL,
+  customer_id uuid NOT NULL,
+  amount DOUBLE PRECISION NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE,
   FOREIGN KEY (customer_id) REFERENCES users(id) ON DELETE CASCADE
 );
 
+ALTER TABLE order_tips ADD CONSTRAINT order_tip_positive CHECK (amount > 0);
+
 create table order_items(
   id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
   order_id uuid NOT NULL,
   menu_id uuid NOT NULL,
+  menu_name VARCHAR(255) NOT NULL,
   quantity int NOT NULL,
+  unit_price DOUBLE PRECISION NOT NULL,
+  line_total DOUBLE PRECISION NOT NULL,
+  preparation_time INT NOT NULL DEFAULT 0,
+  is_done BOOLEAN NOT NULL DEFAULT FALSE,
   FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE,
-  FOREIGN KEY (menu_id) REFERENCES menus(id) ON DELETE CASCADE
+  FOREIGN KEY (menu_id) REFERENCES menus(id) ON DELETE RESTRICT
 );
 
+-- Snapshot of the options chosen for an order item; option_id has no foreign key so history survives menu edits.
+create table order_item_options(
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  order_item_id uuid NOT NULL,
+  option_id uuid NOT NULL,
+  group_name VARCHAR(255) NOT NULL,
+  option_name VARCHAR(255) NOT NULL,
+  price_delta DOUBLE PRECISION NOT NULL,
+  FOREIGN KEY (order_item_id) REFERENCES order_items(id) ON DELETE CASCADE
+);
+
+create table order_status_history(
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  order_id uuid NOT NULL,
+  from_status order_status,
+  to_status order_status NOT NULL,
+  changed_by uuid,
+  note TEXT,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE,
+  FOREIGN KEY (changed_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- menu_id has no foreign key, so a cart line outlives an archived menu and can be flagged instead of vanishing.
+-- menu_name and price keep what the customer saw when the item was added.
+-- option_ids are stored sorted, so every configuration of a menu is its own line and adding it again adds to that line.
+create table cart_items(
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  customer_id uuid NOT NULL,
+  menu_id uuid NOT NULL,
+  menu_name VARCHAR(255) NOT NULL,
+  quantity INT NOT NULL,
+  option_ids TEXT[] NOT NULL DEFAULT '{}',
+  price DOUBLE PRECISION NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (customer_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+ALTER TABLE cart_items ADD CONSTRAINT unique_cart_item UNIQUE (customer_id, menu_id, option_ids);
+ALTER TABLE cart_items ADD CONSTRAINT cart_item_quantity_positive CHECK (quantity > 0);
+
 create table reviews(
   id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
   customer_id uuid NOT NULL,
@@ -107,9 +353,50 @@ create table reviews(
   created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
   updated_at TIMESTAMP,
   FOREIGN KEY (customer_id) REFERENCES users(id) ON DELETE CASCADE,
-  FOREIGN KEY (menu_id) REFERENCES menus(id) ON DELETE CASCADE,
+  FOREIGN KEY (menu_id) REFERENCES menus(id) ON DELETE RESTRICT,
   FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE
 );
 
 ALTER TABLE reviews
 ADD CONSTRAINT unique_review_per_purchase UNIQUE (customer_id, menu_id, order_id);
+
+create table ingredients(
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  unit VARCHAR(50) NOT NULL,
+  quantity_on_hand DOUBLE PRECISION NOT NULL DEFAULT 0,
+  reorder_threshold DOUBLE PRECISION NOT NULL DEFAULT 0,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP
+);
+
+ALTER TABLE ingredients ADD CONSTRAINT unique_ingredient_name UNIQUE (name);
+ALTER TABLE ingredients ADD CONSTRAINT ingredient_reorder_threshold_positive CHECK (reorder_threshold >= 0);
+
+-- Bill of materials: how much of each ingredient one unit of a menu item uses.
+create table menu_recipes(
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  menu_id uuid NOT NULL,
+  ingredient_id uuid NOT NULL,
+  quantity DOUBLE PRECISION NOT NULL,
+  FOREIGN KEY (menu_id) REFERENCES menus(id) ON DELETE CASCADE,
+  FOREIGN KEY (ingredient_id) REFERENCES ingredients(id) ON DELETE RESTRICT
+);
+
+ALTER TABLE menu_recipes ADD CONSTRAINT unique_recipe_ingredient UNIQUE (menu_id, ingredient_id);
+ALTER TABLE menu_recipes ADD CONSTRAINT recipe_quantity_positive CHECK (quantity > 0);
+
+-- Every change to quantity_on_hand; quantity is negative when stock goes out.
+create table stock_movements(
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  ingredient_id uuid NOT NULL,
+  movement_type stock_movement_type NOT NULL,
+  quantity DOUBLE PRECISION NOT NULL,
+  order_id uuid,
+  note TEXT,
+  created_by uuid,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (ingredient_id) REFERENCES ingredients(id) ON DELETE CASCADE,
+  FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE SET NULL,
+  FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE SET NULL
+);
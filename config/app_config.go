@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 const ApiGroup = "/api/v1"
 
 // User Route
@@ -19,6 +21,67 @@ const (
 	GetMenu    = "/menu"
 	UpdateMenu = "/menu/:id"
 	DeleteMenu = "/menu/:id"
+	GetArchivedMenu = "/menu/archived"
+	RestoreMenu     = "/menu/:id/restore"
+	UpdateMenuStock = "/menu/:id/stock"
+	MenuAvailability = "/menu/:id/availability"
+	GetRecipe       = "/menu/:id/recipe"
+	SetRecipe       = "/menu/:id/recipe"
+	UploadMenuImage = "/menu/:id/image"
+	DeleteMenuImage = "/menu/:id/image"
+	AddModifierGroup    = "/menu/:id/modifier-group"
+	DeleteModifierGroup = "/modifier-group/:id"
+)
+
+// Inventory Route
+const (
+	AddIngredient        = "/ingredient"
+	GetIngredient        = "/ingredient"
+	UpdateIngredient     = "/ingredient/:id"
+	IngredientReceipt    = "/ingredient/:id/receipt"
+	IngredientAdjustment = "/ingredient/:id/adjustment"
+	IngredientMovement   = "/ingredient/:id/movements"
+	LowStockIngredient   = "/ingredient/low-stock"
+)
+
+// Category Route
+const (
+	AddMenuCategory    = "/menu-category"
+	GetMenuCategory    = "/menu-category"
+	UpdateMenuCategory = "/menu-category/:id"
+	DeleteMenuCategory = "/menu-category/:id"
+	GetActiveMenuCategory = "/menu/categories"
+	AddUnitType        = "/unit-type"
+	GetUnitType        = "/unit-type"
+	UpdateUnitType     = "/unit-type/:id"
+	DeleteUnitType     = "/unit-type/:id"
+)
+
+// Address Route
+const (
+	AddAddress       = "/address"
+	GetAddress       = "/address"
+	UpdateAddress    = "/address/:id"
+	DeleteAddress    = "/address/:id"
+	GetDeliveryQuote = "/address/:id/delivery-fee"
+)
+
+// Delivery Zone Route
+const (
+	AddDeliveryZone    = "/delivery-zone"
+	GetDeliveryZone    = "/delivery-zone"
+	DeleteDeliveryZone = "/delivery-zone/:id"
+)
+
+// Restaurant Route
+const (
+	StoreStatus       = "/store-status"
+	AddOpeningHour    = "/opening-hours"
+	GetOpeningHour    = "/opening-hours"
+	DeleteOpeningHour = "/opening-hours/:id"
+	AddClosure        = "/closures"
+	GetClosure        = "/closures"
+	DeleteClosure     = "/closures/:id"
 )
 
 // balance Route
@@ -30,10 +93,45 @@ const (
 // Order Route
 const (
 	AddOrder          = "/order"
+	QuoteOrder        = "/order/quote"
 	GetUnfinishOrder  = "/unfinish-order"
 	UpdateOrderStatus = "/order-status/:id"
 	GetAllOrder       = "/order"
 	GetOrderHistory   = "/finish-order"
+	CancelOrder       = "/order/:id/cancel"
+	TipOrder          = "/order/:id/tip"
+	Reorder           = "/order/:id/reorder"
+	CancelOrderStatus = "/order-status/:id/cancel"
+	AssignCourier     = "/order/:id/courier"
+	OrderStream       = "/order-stream"
+	KitchenStream     = "/kitchen-stream"
+	KitchenQueue      = "/kitchen-queue"
+	KitchenCapacity   = "/kitchen-capacity"
+	OrderingPause     = "/ordering-pause"
+	MarkOrderItemDone = "/order/:id/items/:itemId/done"
+)
+
+// Report Route
+const (
+	TipReport = "/report/tips"
+)
+
+// Courier Route
+const (
+	GetDelivery      = "/delivery"
+	PickupDelivery   = "/delivery/:id/pickup"
+	CompleteDelivery = "/delivery/:id/deliver"
+	FailDelivery     = "/delivery/:id/fail"
+)
+
+// Cart Route
+const (
+	GetCart        = "/cart"
+	AddCartItem    = "/cart"
+	ClearCart      = "/cart"
+	UpdateCartItem = "/cart/:id"
+	DeleteCartItem = "/cart/:id"
+	CheckoutCart   = "/cart/checkout"
 )
 
 // Promo Route
@@ -50,4 +148,23 @@ const (
 	GetReview    = "/review"
 	UpdateReview = "/review/:id"
 	DeleteReview = "/review/:id"
-)
\ No newline at end of file
+)
+
+// EventHistorySize is how many order events are kept for Last-Event-ID replay
+const EventHistorySize = 500
+
+// IdempotencyKeyTTL is how long a stored Idempotency-Key response can be replayed
+const IdempotencyKeyTTL = 24 * time.Hour
+
+// IdempotencyClaimTimeout is how long a request may hold an Idempotency-Key without storing a response,
+// after that the claim is treated as abandoned (e.g. the server crashed) and a retry may take it over
+const IdempotencyClaimTimeout = 2 * time.Minute
+
+// UploadsPath is where the engine serves stored files such as menu images
+const UploadsPath = "/uploads"
+
+// UploadsCacheControl lets browsers keep stored files, every upload is saved under a new name
+const UploadsCacheControl = "public, max-age=31536000, immutable"
+
+// MenuThumbnailWidths are the widths in pixels of the thumbnails made for every menu image
+var MenuThumbnailWidths = map[string]int{"small": 160, "medium": 480}
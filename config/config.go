@@ -5,6 +5,7 @@ import (
 	"os"
 	"strconv"
 	"time"
+	_ "time/tzdata"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/joho/godotenv"
@@ -30,10 +31,50 @@ type TokenConfig struct {
 	JwtExpiresTime   time.Duration
 }
 
+type OrderConfig struct{
+	MinLeadTime time.Duration
+	ReleaseBefore time.Duration
+	MaxScheduleDays int
+	MaxActiveOrders int
+	MaxActiveOrdersPerAddress int
+	DeliveryMinutesPerKm float64
+	MaxKitchenOrders int
+	MaxKitchenMinutes int
+}
+
+type MenuConfig struct{
+	LowStockThreshold int
+}
+
+type StorageConfig struct{
+	Dir string
+	PublicUrl string
+	MaxImageSize int64
+	MaxImagePixels int64
+}
+
+type PricingConfig struct{
+	TaxRate float64
+	TaxInclusive bool
+	TaxAfterDiscount bool
+	ServiceChargeRate float64
+}
+
+type RestaurantConfig struct{
+	Latitude float64
+	Longitude float64
+	Location *time.Location
+}
+
 type Config struct{
 	DbConfig
 	ApiConfig
 	TokenConfig
+	OrderConfig
+	RestaurantConfig
+	PricingConfig
+	MenuConfig
+	StorageConfig
 }
 
 func (c *Config) ReadConfig() error {
@@ -68,6 +109,120 @@ func (c *Config) ReadConfig() error {
 		JwtExpiresTime: time.Duration(tokenExpire) * time.Minute,
 	}
 
+	// Parse scheduled order settings from the .env file, with default fallback when they are not set
+	minLeadTime, err := strconv.Atoi(os.Getenv("ORDER_MIN_LEAD_TIME"))
+	if err != nil{
+		minLeadTime = 60
+	}
+	releaseBefore, err := strconv.Atoi(os.Getenv("ORDER_RELEASE_BEFORE"))
+	if err != nil{
+		releaseBefore = 45
+	}
+	maxScheduleDays, err := strconv.Atoi(os.Getenv("ORDER_MAX_SCHEDULE_DAYS"))
+	if err != nil{
+		maxScheduleDays = 7
+	}
+	// Parse the active order policy, a limit of zero or below disables the check
+	maxActiveOrders, err := strconv.Atoi(os.Getenv("ORDER_MAX_ACTIVE"))
+	if err != nil{
+		maxActiveOrders = 1
+	}
+	maxActiveOrdersPerAddress, err := strconv.Atoi(os.Getenv("ORDER_MAX_ACTIVE_PER_ADDRESS"))
+	if err != nil{
+		maxActiveOrdersPerAddress = 1
+	}
+	// Parse the kitchen capacity, a limit of zero or below disables the check
+	maxKitchenOrders, _ := strconv.Atoi(os.Getenv("ORDER_MAX_KITCHEN_ORDERS"))
+	maxKitchenMinutes, _ := strconv.Atoi(os.Getenv("ORDER_MAX_KITCHEN_MINUTES"))
+	// Parse the courier travel time used for the estimated delivery time
+	deliveryMinutesPerKm, err := strconv.ParseFloat(os.Getenv("ORDER_DELIVERY_MINUTES_PER_KM"), 64)
+	if err != nil || deliveryMinutesPerKm < 0{
+		deliveryMinutesPerKm = 3
+	}
+	// Populate the OrderConfig struct with scheduled order settings and the active order policy
+	c.OrderConfig = OrderConfig{
+		MinLeadTime: time.Duration(minLeadTime) * time.Minute,
+		ReleaseBefore: time.Duration(releaseBefore) * time.Minute,
+		MaxScheduleDays: maxScheduleDays,
+		MaxActiveOrders: maxActiveOrders,
+		MaxActiveOrdersPerAddress: maxActiveOrdersPerAddress,
+		DeliveryMinutesPerKm: deliveryMinutesPerKm,
+		MaxKitchenOrders: maxKitchenOrders,
+		MaxKitchenMinutes: maxKitchenMinutes,
+	}
+	// Parse the restaurant location used to measure delivery distances
+	latitude, latErr := strconv.ParseFloat(os.Getenv("RESTAURANT_LATITUDE"), 64)
+	longitude, lngErr := strconv.ParseFloat(os.Getenv("RESTAURANT_LONGITUDE"), 64)
+	if latErr != nil || lngErr != nil || latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180{
+		return fmt.Errorf("invalid RESTAURANT_LATITUDE or RESTAURANT_LONGITUDE")
+	}
+	// Parse the timezone the opening hours are kept in
+	timezone := os.Getenv("RESTAURANT_TIMEZONE")
+	if timezone == ""{
+		timezone = "UTC"
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil{
+		return fmt.Errorf("invalid RESTAURANT_TIMEZONE: %v", err.Error())
+	}
+	c.RestaurantConfig = RestaurantConfig{
+		Latitude: latitude,
+		Longitude: longitude,
+		Location: location,
+	}
+
+	// Parse the tax and service charge rates as percentages, both are off when not set
+	taxRate, _ := strconv.ParseFloat(os.Getenv("TAX_RATE"), 64)
+	serviceChargeRate, _ := strconv.ParseFloat(os.Getenv("SERVICE_CHARGE_RATE"), 64)
+	if taxRate < 0 || taxRate > 100 || serviceChargeRate < 0 || serviceChargeRate > 100{
+		return fmt.Errorf("TAX_RATE and SERVICE_CHARGE_RATE must be between 0 and 100")
+	}
+	taxInclusive, _ := strconv.ParseBool(os.Getenv("TAX_INCLUSIVE"))
+	taxAfterDiscount, err := strconv.ParseBool(os.Getenv("TAX_AFTER_DISCOUNT"))
+	if err != nil{
+		taxAfterDiscount = true
+	}
+	c.PricingConfig = PricingConfig{
+		TaxRate: taxRate,
+		TaxInclusive: taxInclusive,
+		TaxAfterDiscount: taxAfterDiscount,
+		ServiceChargeRate: serviceChargeRate,
+	}
+
+	// Parse the stock count at or below which a menu item is flagged as low on stock
+	lowStockThreshold, err := strconv.Atoi(os.Getenv("MENU_LOW_STOCK_THRESHOLD"))
+	if err != nil || lowStockThreshold < 0{
+		lowStockThreshold = 5
+	}
+	c.MenuConfig = MenuConfig{
+		LowStockThreshold: lowStockThreshold,
+	}
+
+	// Parse where uploaded files are kept and how large a menu image can be
+	storageDir := os.Getenv("STORAGE_DIR")
+	if storageDir == ""{
+		storageDir = "uploads"
+	}
+	publicUrl := os.Getenv("STORAGE_PUBLIC_URL")
+	if publicUrl == ""{
+		publicUrl = UploadsPath
+	}
+	maxImageSizeKb, err := strconv.ParseInt(os.Getenv("MENU_IMAGE_MAX_SIZE_KB"), 10, 64)
+	if err != nil || maxImageSizeKb <= 0{
+		maxImageSizeKb = 2048
+	}
+	// A small file can still decode into a huge image, so the pixel count is limited as well
+	maxImagePixels, err := strconv.ParseInt(os.Getenv("MENU_IMAGE_MAX_PIXELS"), 10, 64)
+	if err != nil || maxImagePixels <= 0{
+		maxImagePixels = 25000000
+	}
+	c.StorageConfig = StorageConfig{
+		Dir: storageDir,
+		PublicUrl: publicUrl,
+		MaxImageSize: maxImageSizeKb * 1024,
+		MaxImagePixels: maxImagePixels,
+	}
+
 	// Validate all required config fields, ensuring none are empty or invalid
 	if c.Host == "" || c.Port == "" || c.User == "" || c.Password == "" || c.Name == "" || c.Driver == "" ||
 	 c.Apiport == "" || c.IssuerName == "" || c.JwtExpiresTime < 0 || len(c.JwtSignatureKey) == 0 {
@@ -11,9 +11,29 @@ var (
 	ErrInvalidEmail = errors.New("invalid email")
 	ErrMissingFields   = errors.New("some required fields are missing")
 	ErrInvalidGender   = errors.New("gender must be either male or female")
-	ErrInvalidMenuType = errors.New("menu type must be main dish, side dish, dessert or beverage")
-	ErrInvalidRole = errors.New("role must be admin, employee or customer")
-	ErrInvalidOrderStatus = errors.New("order status must be preparing, out for delivery, or delivered")
+	ErrInactiveMenuCategory = errors.New("menu category is not active")
+	ErrUnknownMenuCategory = errors.New("menu category is not found")
+	ErrInvalidRole = errors.New("role must be either employee or courier")
+	ErrInvalidOrderStatus = errors.New("order status must be scheduled, confirmed, preparing, ready for pickup, out for delivery, delivery failed, delivered or cancelled")
 	ErrInvalidTransactionType = errors.New("transaction type be either debit or credit")
-	ErrInvalidUnitType = errors.New("unit type must be piece, portion, packet or cup")
+	ErrInactiveUnitType = errors.New("unit type is not active")
+	ErrInsufficientBalance = errors.New("insufficient balance to complete order")
+	ErrEmptyCart = errors.New("cart is empty")
+	ErrEmptyOrder = errors.New("order must have at least one item")
+	ErrMenuConflict = errors.New("an active menu already uses this name or description")
+	ErrCartItemNotFound = errors.New("item is not in the cart")
+	ErrInvalidZoneType = errors.New("zone type must be either radius or polygon")
+	ErrInvalidCoordinates = errors.New("latitude must be between -90 and 90 and longitude between -180 and 180")
+	ErrOutsideDeliveryZone = errors.New("address is outside every delivery zone")
+	ErrRestaurantBusy = errors.New("restaurant is busy and can't take new orders right now")
+	ErrOrderingPaused = errors.New("ordering is paused")
+	ErrRestaurantClosed = errors.New("restaurant is closed")
+	ErrOrderNotOwned = errors.New("the customer doesn't belong to this order")
+	ErrOrderNotCancellable = errors.New("order can't be cancelled at this stage")
+	ErrMenuUnavailable = errors.New("menu item is unavailable")
+	ErrNotEnoughStock = errors.New("not enough stock")
+	ErrImageTooLarge = errors.New("image is too large")
+	ErrUnsupportedImageType = errors.New("image must be a jpeg, png or gif")
+	ErrIdempotencyKeyReused = errors.New("Idempotency-Key was already used with a different request")
+	ErrIdempotencyKeyInProgress = errors.New("a request with this Idempotency-Key is still being processed")
 )
\ No newline at end of file
@@ -6,7 +6,7 @@ const (
 	LoginQuery             = `SELECT id, email, password, username, role FROM users WHERE email = $1`
 	GetUserbyIdQuery       = `SELECT id, username, email, password, role, gender, created_at, updated_at FROM users WHERE id = $1`
 	CountUserQuery         = `SELECT COUNT(*) FROM users`
-	AssignToEmployeeQuery  = `UPDATE users SET role = $2, updated_at = $3 WHERE id = $1`
+	AssignRoleQuery  = `UPDATE users SET role = $2, updated_at = $3 WHERE id = $1`
 	DeleteUserQuery        = `DELETE FROM users WHERE id = $1`
 	GetAllUserQuery     = `SELECT id, username, role, gender, created_at, updated_at FROM users ORDER BY created_at ASC limit $1 OFFSET $2`
 	GetUserFilterQuery  = `SELECT id, username, role, gender, created_at, updated_at FROM users WHERE ROLE = $3 ORDER BY created_at ASC limit $1 OFFSET $2`
@@ -22,54 +22,124 @@ const (
 
 // Menu Query
 const (
-	CreateMenuQuery = `INSERT INTO menus(name, type, description, unit_type, price, created_by, updated_at) VALUES($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at, created_by`
-	GetMenubyNameQuery = "SELECT id, name, price FROM menus WHERE name = $1"
+	CreateMenuQuery = `INSERT INTO menus(name, category_id, description, unit_type_id, price, preparation_time, created_by, updated_at) VALUES($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id, created_at, created_by`
+	GetMenubyNameQuery = `SELECT m.id, m.name, m.price, m.preparation_time, m.is_available, m.stock_left FROM menus m
+	JOIN menu_categories c ON m.category_id = c.id
+	WHERE m.name = $1 AND m.archived_at IS NULL AND c.is_active`
+	GetMenusbyIdsQuery = `SELECT m.id, m.name, m.price, m.is_available, m.stock_left FROM menus m
+	JOIN menu_categories c ON m.category_id = c.id
+	WHERE m.id = ANY($1) AND m.archived_at IS NULL AND c.is_active`
 	GetAllMenuQuery = `
-	SELECT m.id, m.name, m.type, m.description, m.unit_type, m.price,
+	SELECT m.id, m.name, m.category_id, c.name, m.description, m.unit_type_id, t.name, m.price, m.preparation_time,
+	m.is_available, m.daily_stock, m.stock_left, m.image_key,
 	COALESCE(AVG(r.rating), 0) AS rating, u.username AS created_by,
 	m.created_at, m.updated_at FROM menus m
-	JOIN users u on m.created_by = u.id
-	LEFT JOIN reviews r on m.id = r.menu_id
-	GROUP BY m.id, u.username
-	ORDER BY rating DESC, created_at ASC
+	JOIN menu_categories c ON m.category_id = c.id
+	JOIN unit_types t ON m.unit_type_id = t.id
+	JOIN users u ON m.created_by = u.id
+	LEFT JOIN reviews r ON m.id = r.menu_id
+	WHERE m.archived_at IS NULL AND c.is_active
+	GROUP BY m.id, c.id, t.id, u.username
+	ORDER BY c.display_order ASC, c.name ASC, rating DESC, m.created_at ASC
 	LIMIT $1 OFFSET $2`
-	GetAllMenuWithAllFilterQuery = `SELECT m.id, m.name, m.type, m.description, m.unit_type, m.price,
+	GetAllMenuWithAllFilterQuery = `SELECT m.id, m.name, m.category_id, c.name, m.description, m.unit_type_id, t.name, m.price, m.preparation_time,
+	m.is_available, m.daily_stock, m.stock_left, m.image_key,
 	COALESCE(AVG(r.rating), 0) AS rating, u.username AS created_by,
 	m.created_at, m.updated_at FROM menus m
-	JOIN users u on m.created_by = u.id
-	LEFT JOIN reviews r on m.id = r.menu_id
-	WHERE m.type = $3 AND m.name LIKE '%' || $4 || '%'
-	GROUP BY m.id, u.username
-	ORDER BY rating DESC, created_at ASC
+	JOIN menu_categories c ON m.category_id = c.id
+	JOIN unit_types t ON m.unit_type_id = t.id
+	JOIN users u ON m.created_by = u.id
+	LEFT JOIN reviews r ON m.id = r.menu_id
+	WHERE m.archived_at IS NULL AND c.is_active AND m.category_id = $3 AND m.name LIKE '%' || $4 || '%'
+	GROUP BY m.id, c.id, t.id, u.username
+	ORDER BY c.display_order ASC, c.name ASC, rating DESC, m.created_at ASC
 	LIMIT $1 OFFSET $2`
-	GetAllMenuWithFilterNameQuery = `SELECT m.id, m.name, m.type, m.description, m.unit_type, m.price,
+	GetAllMenuWithFilterNameQuery = `SELECT m.id, m.name, m.category_id, c.name, m.description, m.unit_type_id, t.name, m.price, m.preparation_time,
+	m.is_available, m.daily_stock, m.stock_left, m.image_key,
 	COALESCE(AVG(r.rating), 0) AS rating, u.username AS created_by,
 	m.created_at, m.updated_at FROM menus m
+	JOIN menu_categories c ON m.category_id = c.id
+	JOIN unit_types t ON m.unit_type_id = t.id
 	JOIN users u ON m.created_by = u.id
 	LEFT JOIN reviews r ON m.id = r.menu_id
-	WHERE m.name LIKE '%' || $3 || '%'
-	GROUP BY m.id, u.username
-	ORDER BY rating DESC, created_at ASC
+	WHERE m.archived_at IS NULL AND c.is_active AND m.name LIKE '%' || $3 || '%'
+	GROUP BY m.id, c.id, t.id, u.username
+	ORDER BY c.display_order ASC, c.name ASC, rating DESC, m.created_at ASC
 	LIMIT $1 OFFSET $2`
-	GetAllMenuWithFilterTypeQuery = `SELECT m.id, m.name, m.type, m.description, m.unit_type, m.price,
+	GetAllMenuWithFilterCategoryQuery = `SELECT m.id, m.name, m.category_id, c.name, m.description, m.unit_type_id, t.name, m.price, m.preparation_time,
+	m.is_available, m.daily_stock, m.stock_left, m.image_key,
 	COALESCE(AVG(r.rating), 0) AS rating, u.username AS created_by,
 	m.created_at, m.updated_at FROM menus m
+	JOIN menu_categories c ON m.category_id = c.id
+	JOIN unit_types t ON m.unit_type_id = t.id
 	JOIN users u ON m.created_by = u.id
 	LEFT JOIN reviews r ON m.id = r.menu_id
-	WHERE m.type = $3
-	GROUP BY m.id, u.username
-	ORDER BY rating DESC, created_at ASC
+	WHERE m.archived_at IS NULL AND c.is_active AND m.category_id = $3
+	GROUP BY m.id, c.id, t.id, u.username
+	ORDER BY c.display_order ASC, c.name ASC, rating DESC, m.created_at ASC
 	LIMIT $1 OFFSET $2`
-	GetMenubyIdQuery = `SELECT id, name, type, description, unit_type, price, created_by, created_at, updated_at FROM menus WHERE id = $1`
-	UpdateMenuQuery = `UPDATE menus SET name = $2, type = $3, description = $4, unit_type = $5, price = $6, updated_at = $7 WHERE id = $1`
-	DeleteMenuQuery = "DELETE FROM menus WHERE id = $1"
-	CountMenuQuery = `SELECT COUNT(*) FROM menus`
+	GetMenubyIdQuery = `SELECT m.id, m.name, m.category_id, c.name, m.description, m.unit_type_id, t.name, m.price, m.preparation_time,
+	m.is_available, m.daily_stock, m.stock_left, m.created_by, m.created_at, m.updated_at, m.archived_at, m.image_key FROM menus m
+	JOIN menu_categories c ON m.category_id = c.id
+	JOIN unit_types t ON m.unit_type_id = t.id
+	WHERE m.id = $1`
+	UpdateMenuQuery = `UPDATE menus SET name = $2, category_id = $3, description = $4, unit_type_id = $5, price = $6, preparation_time = $7, updated_at = $8 WHERE id = $1`
+	UpdateMenuImageQuery = "UPDATE menus SET image_key = $2, updated_at = $3 WHERE id = $1"
+	ArchiveMenuQuery = "UPDATE menus SET archived_at = $2 WHERE id = $1 AND archived_at IS NULL"
+	RestoreMenuQuery = "UPDATE menus SET archived_at = NULL, updated_at = $2 WHERE id = $1 AND archived_at IS NOT NULL"
+	GetArchivedMenusQuery = `SELECT m.id, m.name, m.category_id, c.name, m.description, m.unit_type_id, t.name, m.price, m.preparation_time,
+	m.is_available, m.daily_stock, m.stock_left, m.image_key,
+	COALESCE(AVG(r.rating), 0) AS rating, u.username AS created_by,
+	m.created_at, m.updated_at, m.archived_at FROM menus m
+	JOIN menu_categories c ON m.category_id = c.id
+	JOIN unit_types t ON m.unit_type_id = t.id
+	JOIN users u ON m.created_by = u.id
+	LEFT JOIN reviews r ON m.id = r.menu_id
+	WHERE m.archived_at IS NOT NULL
+	GROUP BY m.id, c.id, t.id, u.username
+	ORDER BY m.archived_at DESC`
+	CountMenuQuery = `SELECT COUNT(*) FROM menus m JOIN menu_categories c ON m.category_id = c.id WHERE m.archived_at IS NULL AND c.is_active`
+	UpdateMenuStockQuery = `UPDATE menus SET daily_stock = $2, stock_left = $3, updated_at = $4 WHERE id = $1`
+	UpdateMenuAvailabilityQuery = `UPDATE menus SET is_available = $2, updated_at = $3 WHERE id = $1`
+	ReserveMenuStockQuery = `UPDATE menus SET stock_left = stock_left - $2
+	WHERE id = $1 AND is_available AND archived_at IS NULL AND (stock_left IS NULL OR stock_left >= $2)`
+	GetMenuStockQuery = `SELECT name, is_available, stock_left FROM menus WHERE id = $1`
+	RestoreOrderStockQuery = `UPDATE menus m SET stock_left = LEAST(m.stock_left + oi.quantity, m.daily_stock)
+	FROM (SELECT menu_id, SUM(quantity) AS quantity FROM order_items WHERE order_id = $1 GROUP BY menu_id) oi
+	WHERE m.id = oi.menu_id AND m.stock_left IS NOT NULL`
+	ResetDailyStockQuery = `UPDATE menus SET stock_left = daily_stock WHERE daily_stock IS NOT NULL`
+)
+
+// Modifier Query
+const (
+	CreateModifierGroupQuery = `INSERT INTO menu_modifier_groups(menu_id, name, is_required, min_select, max_select) VALUES($1, $2, $3, $4, $5) RETURNING id, created_at`
+	CreateModifierOptionQuery = `INSERT INTO menu_modifier_options(group_id, name, price_delta) VALUES($1, $2, $3) RETURNING id`
+	GetModifierGroupsByMenuIdsQuery = `SELECT g.id, g.menu_id, g.name, g.is_required, g.min_select, g.max_select, o.id, o.name, o.price_delta
+	FROM menu_modifier_groups g JOIN menu_modifier_options o ON o.group_id = g.id
+	WHERE g.menu_id = ANY($1)
+	ORDER BY g.created_at ASC, o.created_at ASC`
+	DeleteModifierGroupQuery = `DELETE FROM menu_modifier_groups WHERE id = $1`
+)
+
+// Cart Query
+const (
+	AddCartItemQuery = `INSERT INTO cart_items(customer_id, menu_id, menu_name, quantity, option_ids, price) VALUES($1, $2, $3, $4, $5, $6)
+	ON CONFLICT (customer_id, menu_id, option_ids) DO UPDATE SET menu_name = EXCLUDED.menu_name, quantity = cart_items.quantity + EXCLUDED.quantity,
+	price = EXCLUDED.price, updated_at = CURRENT_TIMESTAMP RETURNING id, quantity, created_at, updated_at`
+	GetCartItemsQuery = `SELECT id, menu_id, menu_name, quantity, option_ids, price, created_at, updated_at FROM cart_items WHERE customer_id = $1 ORDER BY created_at ASC`
+	GetCartItemQuery = `SELECT id, menu_id, menu_name, quantity, option_ids, price, created_at, updated_at FROM cart_items WHERE customer_id = $1 AND id = $2`
+	UpdateCartItemQuery = `UPDATE cart_items SET menu_name = $3, quantity = $4, option_ids = $5, price = $6, updated_at = CURRENT_TIMESTAMP WHERE customer_id = $1 AND id = $2`
+	DeleteCartItemQuery = `DELETE FROM cart_items WHERE customer_id = $1 AND id = $2`
+	ClearCartQuery = `DELETE FROM cart_items WHERE customer_id = $1`
 )
 
 // Balance Query
 const (
 	CreateBalanceQuery = `INSERT INTO balances(customer_id, transaction_type, amount, description, balance) VALUES($1, $2, $3, $4, $5) RETURNING id, balance, created_at`
-	GetUserBalanceQuery = `SELECT balance FROM balances WHERE customer_id = $1 ORDER BY created_at DESC LIMIT 1`
+	GetUserBalanceQuery = `SELECT balance FROM wallets WHERE customer_id = $1`
+	CreateWalletQuery = `INSERT INTO wallets(customer_id) VALUES($1) ON CONFLICT (customer_id) DO NOTHING`
+	LockUserBalanceQuery = `SELECT balance FROM wallets WHERE customer_id = $1 FOR UPDATE`
+	UpdateUserBalanceQuery = `UPDATE wallets SET balance = $2, updated_at = CURRENT_TIMESTAMP WHERE customer_id = $1`
 	GetAllUserBalanceQuery = `SELECT b.id, u.username AS customer_name, b.transaction_type, b.amount, b.description, b.balance, b.created_at FROM balances b JOIN users u ON b.customer_id = u.id WHERE b.customer_id = $3 ORDER BY b.created_at ASC LIMIT $1 OFFSET $2`
 	CountUserBalanceQuery = "SELECT COUNT (*) FROM balances WHERE customer_id = $1"
 )
@@ -81,29 +151,95 @@ const (
 	GetAllPromoQuery = `SELECT id, promo_code, discount, is_percentage, start_date, end_date, description, created_at, updated_at FROM promos ORDER BY created_at ASC LIMIT $1 OFFSET $2`
 	GetPromoForCustomerQuery = `SELECT id, promo_code, discount, is_percentage, start_date, end_date, description,
 	created_at, updated_at FROM promos p
-	WHERE NOT EXISTS (SELECT 1 FROM orders o WHERE o.promo_code = p.promo_code AND o.customer_id = $3)
+	WHERE NOT EXISTS (SELECT 1 FROM orders o WHERE o.promo_code = p.promo_code AND o.customer_id = $3 AND o.promo_used = TRUE)
 	ORDER BY created_at ASC LIMIT $1 OFFSET $2`
 	CountPromoQuery = `SELECT COUNT(*) FROM promos`
 	CountPromoForCustomerQuery = `SELECT COUNT(*) FROM promos p 
-	WHERE NOT EXISTS (SELECT 1 FROM orders o WHERE o.promo_code = p.promo_code AND o.customer_id = $1)`
+	WHERE NOT EXISTS (SELECT 1 FROM orders o WHERE o.promo_code = p.promo_code AND o.customer_id = $1 AND o.promo_used = TRUE)`
 	GetPromoByIdQuery = `SELECT id FROM promos where id = $1`
 	DeletePromoQuery = `DELETE FROM promos WHERE id = $1`
 )
 
+// Restaurant Settings Query
+const (
+	GetRestaurantSettingsQuery = `SELECT ordering_paused, COALESCE(pause_reason, ''), updated_at FROM restaurant_settings`
+	UpdateOrderingPauseQuery = `UPDATE restaurant_settings SET ordering_paused = $1, pause_reason = $2, updated_by = $3, updated_at = CURRENT_TIMESTAMP RETURNING updated_at`
+	CreateOpeningHourQuery = `INSERT INTO opening_hours(day_of_week, open_time, close_time) VALUES($1, $2, $3) RETURNING id`
+	GetOpeningHoursQuery = `SELECT id, day_of_week, TO_CHAR(open_time, 'HH24:MI'), TO_CHAR(close_time, 'HH24:MI') FROM opening_hours ORDER BY day_of_week, open_time`
+	DeleteOpeningHourQuery = `DELETE FROM opening_hours WHERE id = $1`
+	CreateClosureQuery = `INSERT INTO restaurant_closures(closure_date, is_closed, open_time, close_time, reason) VALUES($1, $2, $3, $4, $5) RETURNING id`
+	GetClosuresQuery = `SELECT id, TO_CHAR(closure_date, 'YYYY-MM-DD'), is_closed, COALESCE(TO_CHAR(open_time, 'HH24:MI'), ''), COALESCE(TO_CHAR(close_time, 'HH24:MI'), ''), COALESCE(reason, '')
+	FROM restaurant_closures WHERE closure_date >= $1 ORDER BY closure_date`
+	DeleteClosureQuery = `DELETE FROM restaurant_closures WHERE id = $1`
+)
+
+// Idempotency Key Query
+const (
+	CreateIdempotencyKeyQuery = `INSERT INTO idempotency_keys(user_id, idempotency_key, request_hash) VALUES($1, $2, $3)
+	ON CONFLICT (user_id, idempotency_key) DO NOTHING RETURNING id, created_at`
+	GetIdempotencyKeyQuery = `SELECT id, request_hash, COALESCE(status_code, 0), response_body, created_at FROM idempotency_keys WHERE user_id = $1 AND idempotency_key = $2`
+	SaveIdempotencyResponseQuery = `UPDATE idempotency_keys SET status_code = $2, response_body = $3 WHERE id = $1`
+	DeleteIdempotencyKeyQuery = `DELETE FROM idempotency_keys WHERE id = $1`
+	ReclaimIdempotencyKeyQuery = `UPDATE idempotency_keys SET created_at = CURRENT_TIMESTAMP WHERE id = $1 AND status_code IS NULL
+	AND created_at < CURRENT_TIMESTAMP - $2 * INTERVAL '1 second' RETURNING created_at`
+	DeleteExpiredIdempotencyKeyQuery = `DELETE FROM idempotency_keys WHERE created_at < CURRENT_TIMESTAMP - $1 * INTERVAL '1 second'`
+)
+
+// Customer Address Query
+const (
+	CreateCustomerAddressQuery = `INSERT INTO customer_addresses(customer_id, label, address, latitude, longitude) VALUES($1, $2, $3, $4, $5) RETURNING id, created_at`
+	GetCustomerAddressesQuery = `SELECT id, COALESCE(label, ''), address, latitude, longitude, created_at, updated_at FROM customer_addresses WHERE customer_id = $1 ORDER BY created_at ASC`
+	GetCustomerAddressByIdQuery = `SELECT id, COALESCE(label, ''), address, latitude, longitude, created_at, updated_at FROM customer_addresses WHERE id = $1 AND customer_id = $2`
+	UpdateCustomerAddressQuery = `UPDATE customer_addresses SET label = $3, address = $4, latitude = $5, longitude = $6, updated_at = $7 WHERE id = $1 AND customer_id = $2 RETURNING created_at`
+	DeleteCustomerAddressQuery = `DELETE FROM customer_addresses WHERE id = $1 AND customer_id = $2`
+)
+
+// Delivery Zone Query
+const (
+	CreateDeliveryZoneQuery = `INSERT INTO delivery_zones(name, zone_type, radius_km, polygon) VALUES($1, $2, $3, $4) RETURNING id, created_at`
+	CreateDeliveryZoneFeeQuery = `INSERT INTO delivery_zone_fees(zone_id, max_distance_km, fee) VALUES($1, $2, $3) RETURNING id`
+	GetAllDeliveryZonesQuery = `SELECT z.id, z.name, z.zone_type, COALESCE(z.radius_km, 0), z.polygon, f.id, f.max_distance_km, f.fee
+	FROM delivery_zones z JOIN delivery_zone_fees f ON f.zone_id = z.id
+	ORDER BY z.created_at ASC, z.id, f.max_distance_km ASC`
+	DeleteDeliveryZoneQuery = `DELETE FROM delivery_zones WHERE id = $1`
+)
+
 // Order Query
 const (
-	CreateOrderQuery = `INSERT INTO orders(customer_id, address, promo_code, order_status, note, date, total_price) VALUES($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at`
-	CreateOrderItemQuery = `INSERT INTO order_items(order_id, menu_id, quantity) VALUES($1, $2, $3) RETURNING id`
-	CountunfinishCustomerOrderQuery = `SELECT COUNT(*) FROM orders WHERE customer_id = $1 AND order_status != 'delivered'`
-	GetUnfinishOrderByCustomerIdQuery = `SELECT o.id, o.address, o.promo_code, o.order_status, o.note, o.total_price, o.created_at FROM orders o JOIN users u ON o.customer_id = u.id WHERE o.customer_id = $1 AND order_status != 'delivered' LIMIT 1`
-	GetOrderByIdQuery = `SELECT o.id, u.username AS customer_name, o.address, o.promo_code, o.order_status, o.note, o.total_price, o.created_at FROM orders o JOIN users u ON o.customer_id = u.id WHERE o.id = $1`
+	CreateOrderQuery = `INSERT INTO orders(customer_id, address_id, address, promo_code, order_status, note, date, scheduled_at, subtotal, discount_amount, delivery_distance, delivery_fee, service_charge, tax_amount, tax_inclusive, total_price) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16) RETURNING id, created_at`
+	CreateOrderItemQuery = `INSERT INTO order_items(order_id, menu_id, menu_name, quantity, unit_price, line_total, preparation_time) VALUES($1, $2, $3, $4, $5, $6, $7) RETURNING id`
+	CreateOrderItemOptionQuery = `INSERT INTO order_item_options(order_item_id, option_id, group_name, option_name, price_delta) VALUES($1, $2, $3, $4, $5)`
+	GetOrderItemOptionsByOrderIdQuery = `SELECT oio.order_item_id, oio.option_id, oio.group_name, oio.option_name, oio.price_delta
+	FROM order_item_options oio JOIN order_items oi ON oio.order_item_id = oi.id
+	WHERE oi.order_id = $1`
+	CountunfinishCustomerOrderQuery = `SELECT COUNT(*) FROM orders WHERE customer_id = $1 AND order_status NOT IN ('scheduled', 'delivered', 'cancelled')`
+	CountUnfinishCustomerOrderByAddressQuery = `SELECT COUNT(*) FROM orders WHERE customer_id = $1 AND address_id = $2 AND order_status NOT IN ('scheduled', 'delivered', 'cancelled')`
+	GetUnfinishOrderByCustomerIdQuery = `SELECT o.id, o.address, o.promo_code, o.order_status, o.note, o.scheduled_at, o.subtotal, o.discount_amount, o.delivery_fee, o.service_charge, o.tax_amount, o.tax_inclusive, o.total_price, o.tip_amount, o.created_at,
+	o.delivery_distance, COALESCE(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP - (SELECT MAX(h.created_at) FROM order_status_history h WHERE h.order_id = o.id)), 0)::INT
+	FROM orders o JOIN users u ON o.customer_id = u.id WHERE o.customer_id = $1 AND order_status NOT IN ('delivered', 'cancelled') ORDER BY o.order_status = 'scheduled', o.created_at ASC`
+	GetOrderByIdQuery = `SELECT o.id, u.username AS customer_name, COALESCE(c.username, '') AS courier_name, o.address, o.promo_code, o.order_status, o.note, o.scheduled_at, o.subtotal, o.discount_amount, o.delivery_fee, o.service_charge, o.tax_amount, o.tax_inclusive, o.total_price, o.tip_amount, COALESCE(o.cancel_reason, ''), o.created_at
+	FROM orders o JOIN users u ON o.customer_id = u.id LEFT JOIN users c ON o.courier_id = c.id WHERE o.id = $1`
 	GetAllOrderQuery = `SELECT o.id, u.username AS customer_name, o.address, o.promo_code,
-	o.order_status, o.note, o.total_price, o.created_at
+	o.order_status, o.note, o.scheduled_at, o.subtotal, o.discount_amount, o.delivery_fee, o.service_charge, o.tax_amount, o.tax_inclusive, o.total_price, o.tip_amount, o.created_at
 	FROM orders o JOIN users u ON o.customer_id = u.id
 	WHERE o.order_status = ANY($3)
 	ORDER BY o.created_at ASC LIMIT $1 OFFSET $2`
-	GetOrderItemsByOrderIdQuery = `SELECT oi.id, oi.order_id, m.name AS menu_name, oi.quantity FROM order_items oi JOIN menus m ON oi.menu_id = m.id WHERE oi.order_id = $1`
+	GetOrderItemsByOrderIdQuery = `SELECT id, order_id, menu_name, quantity, unit_price, line_total, preparation_time, is_done FROM order_items WHERE order_id = $1`
+	GetKitchenQueueQuery = `SELECT o.id, u.username AS customer_name, o.order_status, o.note, o.scheduled_at, o.created_at
+	FROM orders o JOIN users u ON o.customer_id = u.id
+	WHERE o.order_status IN ('confirmed', 'preparing')
+	ORDER BY COALESCE(o.scheduled_at, o.created_at::TIMESTAMPTZ) ASC, o.created_at ASC`
+	MarkOrderItemDoneQuery = `UPDATE order_items oi SET is_done = TRUE FROM orders o
+	WHERE oi.order_id = o.id AND oi.order_id = $1 AND oi.id = $2 AND o.order_status IN ('confirmed', 'preparing')`
+	GetCustomerOrderForReorderQuery = `SELECT id, customer_id, COALESCE(address_id::TEXT, ''), order_status, note FROM orders WHERE id = $1 AND customer_id = $2`
+	GetOrderItemMenusByOrderIdQuery = `SELECT id, menu_id FROM order_items WHERE order_id = $1`
+	GetOrderStockItemsQuery = `SELECT menu_id, SUM(quantity) FROM order_items WHERE order_id = $1 GROUP BY menu_id`
 	UpdateOrderStatusQuery = `UPDATE orders SET order_status = $2 WHERE id = $1`
+	CreateOrderStatusHistoryQuery = `INSERT INTO order_status_history(order_id, from_status, to_status, changed_by, note) VALUES($1, $2, $3, $4, $5) RETURNING id, created_at`
+	GetOrderStatusHistoryQuery = `SELECT COALESCE(h.from_status::TEXT, ''), h.to_status, COALESCE(u.username, ''), COALESCE(h.note, ''), h.created_at
+	FROM order_status_history h
+	LEFT JOIN users u ON h.changed_by = u.id
+	WHERE h.order_id = $1 ORDER BY h.created_at ASC`
 	CountfinishCustomerOrderQuery = `SELECT COUNT(*) FROM order_items oi
 	JOIN orders o ON oi.order_id = o.id
 	JOIN menus m ON oi.menu_id = m.id
@@ -111,11 +247,11 @@ const (
 	AND o.customer_id = $2
 	AND o.id = $3
 	AND o.order_status = 'delivered'`
-	GetCustomerOrderHistoryQuery = `SELECT o.id, o.address, o.promo_code, o.order_status, o.note, o.date, o.total_price,
+	GetCustomerOrderHistoryQuery = `SELECT o.id, o.address, o.promo_code, o.order_status, o.note, o.date, o.subtotal, o.discount_amount, o.delivery_fee, o.service_charge, o.tax_amount, o.tax_inclusive, o.total_price, o.tip_amount,
 	o.created_at FROM orders o
 	JOIN users u ON o.customer_id = u.id
 	WHERE o.customer_id = $3 AND o.order_status = 'delivered' ORDER BY o.created_at ASC LIMIT $1 OFFSET $2`
-	GetFilterDateCustomerOrderHistoryQuery = `SELECT o.id, o.address, o.promo_code, o.order_status, o.note, o.date, o.total_price,
+	GetFilterDateCustomerOrderHistoryQuery = `SELECT o.id, o.address, o.promo_code, o.order_status, o.note, o.date, o.subtotal, o.discount_amount, o.delivery_fee, o.service_charge, o.tax_amount, o.tax_inclusive, o.total_price, o.tip_amount,
 	o.created_at FROM orders o
 	JOIN users u ON o.customer_id = u.id
 	WHERE o.date BETWEEN $3 AND $4 AND o.customer_id = $5 AND o.order_status = 'delivered' ORDER BY o.created_at ASC LIMIT $1 OFFSET $2`
@@ -124,6 +260,26 @@ const (
 	CountFinishCustomerOrderQuery = `SELECT COUNT(*) FROM orders WHERE customer_id = $1 AND order_status = 'delivered'`
 	CountUsagePromoQuery = `SELECT COUNT(*) FROM orders WHERE customer_id = $1 AND promo_code = $2 AND promo_used = 'TRUE'`
 	UpdatePromoUsedStatusQuery = `UPDATE orders SET promo_used = TRUE WHERE id = $1`
+	GetOrderForUpdateQuery = `SELECT id, customer_id, COALESCE(courier_id::TEXT, ''), address, promo_code, order_status, note, total_price, tip_amount, created_at FROM orders WHERE id = $1 FOR UPDATE`
+	AssignCourierQuery = `UPDATE orders SET courier_id = $2 WHERE id = $1`
+	CountCourierQuery = `SELECT COUNT(*) FROM users WHERE id = $1 AND role = 'courier'`
+	GetLeastLoadedCourierQuery = `SELECT u.id FROM users u
+	LEFT JOIN orders o ON o.courier_id = u.id AND o.order_status IN ('ready for pickup', 'out for delivery', 'delivery failed')
+	WHERE u.role = 'courier'
+	GROUP BY u.id
+	ORDER BY COUNT(o.id) ASC, u.created_at ASC LIMIT 1`
+	GetCourierOrderQuery = `SELECT o.id, u.username AS customer_name, o.address, o.order_status, o.note, o.subtotal, o.discount_amount, o.delivery_fee, o.service_charge, o.tax_amount, o.tax_inclusive, o.total_price, o.tip_amount, o.created_at
+	FROM orders o JOIN users u ON o.customer_id = u.id
+	WHERE o.courier_id = $1 AND o.order_status IN ('ready for pickup', 'out for delivery', 'delivery failed')
+	ORDER BY o.created_at ASC`
+	GetDueScheduledOrderQuery = `SELECT id FROM orders WHERE order_status = 'scheduled' AND scheduled_at <= $1 ORDER BY scheduled_at ASC`
+	CancelOrderQuery = `UPDATE orders SET order_status = 'cancelled', cancel_reason = $2, promo_used = FALSE WHERE id = $1`
+	CreateOrderTipQuery = `INSERT INTO order_tips(order_id, customer_id, amount) VALUES($1, $2, $3) RETURNING id, created_at`
+	AddOrderTipQuery = `UPDATE orders SET tip_amount = tip_amount + $2 WHERE id = $1`
+	GetTipReportQuery = `SELECT TO_CHAR(DATE_TRUNC($3, t.created_at AT TIME ZONE $4), 'YYYY-MM-DD') AS period, COUNT(*), SUM(t.amount)
+	FROM order_tips t JOIN orders o ON t.order_id = o.id
+	WHERE t.created_at >= $1 AND t.created_at < $2 AND o.order_status <> 'cancelled'
+	GROUP BY period ORDER BY period ASC`
 )
 
 // Review Query
@@ -144,3 +300,54 @@ const (
 	DeleteReviewQuery = `DELETE FROM reviews WHERE id = $1 AND customer_id = $2`
 	CountReviewQuery = `SELECT COUNT(*) FROM reviews`
 )
+
+// Inventory Query
+const (
+	CreateIngredientQuery = `INSERT INTO ingredients(name, unit, reorder_threshold, updated_at) VALUES($1, $2, $3, $4) RETURNING id, created_at`
+	GetIngredientsQuery = `SELECT id, name, unit, quantity_on_hand, reorder_threshold, created_at, updated_at FROM ingredients ORDER BY name ASC`
+	GetIngredientByIdQuery = `SELECT id, name, unit, quantity_on_hand, reorder_threshold, created_at, updated_at FROM ingredients WHERE id = $1`
+	UpdateIngredientQuery = `UPDATE ingredients SET name = $2, unit = $3, reorder_threshold = $4, updated_at = $5 WHERE id = $1`
+	AdjustIngredientQuantityQuery = `UPDATE ingredients SET quantity_on_hand = quantity_on_hand + $2, updated_at = $3 WHERE id = $1
+	RETURNING id, name, unit, quantity_on_hand, reorder_threshold, created_at, updated_at`
+	CreateStockMovementQuery = `INSERT INTO stock_movements(ingredient_id, movement_type, quantity, order_id, note, created_by)
+	VALUES($1, $2, $3, $4, $5, $6) RETURNING id, created_at`
+	GetStockMovementsQuery = `SELECT id, movement_type, quantity, order_id, note, created_at FROM stock_movements
+	WHERE ingredient_id = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3`
+	CountStockMovementQuery = `SELECT COUNT(*) FROM stock_movements WHERE ingredient_id = $1`
+	ConsumeOrderIngredientsQuery = `UPDATE ingredients i SET quantity_on_hand = i.quantity_on_hand - c.quantity, updated_at = $2
+	FROM (SELECT r.ingredient_id, SUM(r.quantity * oi.quantity) AS quantity FROM order_items oi
+	JOIN menu_recipes r ON r.menu_id = oi.menu_id WHERE oi.order_id = $1 GROUP BY r.ingredient_id) c
+	WHERE i.id = c.ingredient_id`
+	CreateConsumptionMovementsQuery = `INSERT INTO stock_movements(ingredient_id, movement_type, quantity, order_id, note, created_by)
+	SELECT r.ingredient_id, 'consumption', -SUM(r.quantity * oi.quantity), $1, 'delivered order', $2 FROM order_items oi
+	JOIN menu_recipes r ON r.menu_id = oi.menu_id WHERE oi.order_id = $1 GROUP BY r.ingredient_id`
+	GetRecipeQuery = `SELECT r.ingredient_id, i.name, i.unit, r.quantity FROM menu_recipes r
+	JOIN ingredients i ON i.id = r.ingredient_id WHERE r.menu_id = $1 ORDER BY i.name ASC`
+	DeleteRecipeQuery = `DELETE FROM menu_recipes WHERE menu_id = $1`
+	CreateRecipeItemQuery = `INSERT INTO menu_recipes(menu_id, ingredient_id, quantity) VALUES($1, $2, $3)`
+	GetLowStockIngredientsQuery = `SELECT i.id, i.name, i.unit, i.quantity_on_hand, i.reorder_threshold, i.created_at, i.updated_at,
+	COALESCE(array_agg(m.name ORDER BY m.name) FILTER (WHERE m.id IS NOT NULL), '{}') AS menus
+	FROM ingredients i
+	LEFT JOIN menu_recipes r ON r.ingredient_id = i.id
+	LEFT JOIN menus m ON m.id = r.menu_id AND m.archived_at IS NULL
+	WHERE i.quantity_on_hand <= i.reorder_threshold
+	GROUP BY i.id
+	ORDER BY i.name ASC`
+)
+
+// Menu Category Query
+const (
+	CreateMenuCategoryQuery = `INSERT INTO menu_categories(name, description, display_order, is_active, updated_at) VALUES($1, $2, $3, $4, $5) RETURNING id, created_at`
+	GetMenuCategoriesQuery = `SELECT id, name, COALESCE(description, ''), display_order, is_active FROM menu_categories ORDER BY display_order ASC, name ASC`
+	GetActiveMenuCategoriesQuery = `SELECT id, name, COALESCE(description, ''), display_order, is_active FROM menu_categories WHERE is_active ORDER BY display_order ASC, name ASC`
+	GetMenuCategoryByIdQuery = `SELECT id, name, COALESCE(description, ''), display_order, is_active FROM menu_categories WHERE id = $1`
+	GetActiveMenuCategoryByIdOrNameQuery = `SELECT id, name, COALESCE(description, ''), display_order, is_active FROM menu_categories
+	WHERE is_active AND (id::TEXT = LOWER($1) OR LOWER(name) = LOWER($1))`
+	UpdateMenuCategoryQuery = `UPDATE menu_categories SET name = $2, description = $3, display_order = $4, is_active = $5, updated_at = $6 WHERE id = $1`
+	DeleteMenuCategoryQuery = `DELETE FROM menu_categories WHERE id = $1`
+	CreateUnitTypeQuery = `INSERT INTO unit_types(name, description, display_order, is_active, updated_at) VALUES($1, $2, $3, $4, $5) RETURNING id, created_at`
+	GetUnitTypesQuery = `SELECT id, name, COALESCE(description, ''), display_order, is_active FROM unit_types ORDER BY display_order ASC, name ASC`
+	GetUnitTypeByIdQuery = `SELECT id, name, COALESCE(description, ''), display_order, is_active FROM unit_types WHERE id = $1`
+	UpdateUnitTypeQuery = `UPDATE unit_types SET name = $2, description = $3, display_order = $4, is_active = $5, updated_at = $6 WHERE id = $1`
+	DeleteUnitTypeQuery = `DELETE FROM unit_types WHERE id = $1`
+)
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
 	"food-delivery-apps/shared"
 	"food-delivery-apps/usecase"
 
@@ -13,13 +14,24 @@ import (
 
 type AdminController struct {
 	uc usecase.UserUseCase
+	deliveryUc usecase.DeliveryUseCase
+	restaurantUc usecase.RestaurantUseCase
 	rg *gin.RouterGroup
 }
 
 func (c *AdminController) Route() {
 	c.rg.GET(config.GetAllUser, c.GetAllUserHandler)
-	c.rg.PATCH(config.Role, c.AssignToEmployeeHandler)
+	c.rg.PATCH(config.Role, c.AssignRoleHandler)
 	c.rg.DELETE(config.DeleteUser, c.DeleteUserHandler)
+	c.rg.POST(config.AddDeliveryZone, c.AddDeliveryZoneHandler)
+	c.rg.GET(config.GetDeliveryZone, c.GetDeliveryZoneHandler)
+	c.rg.DELETE(config.DeleteDeliveryZone, c.DeleteDeliveryZoneHandler)
+	c.rg.POST(config.AddOpeningHour, c.AddOpeningHourHandler)
+	c.rg.GET(config.GetOpeningHour, c.GetOpeningHourHandler)
+	c.rg.DELETE(config.DeleteOpeningHour, c.DeleteOpeningHourHandler)
+	c.rg.POST(config.AddClosure, c.AddClosureHandler)
+	c.rg.GET(config.GetClosure, c.GetClosureHandler)
+	c.rg.DELETE(config.DeleteClosure, c.DeleteClosureHandler)
 }
 
 // @Summary Get Users
@@ -70,12 +82,13 @@ func (c *AdminController) GetAllUserHandler(ctx *gin.Context){
 }
 
 // @Summary Update User Role.
-// @Description Update user's role from customer to employee.
+// @Description Update user's role from customer to employee or courier. Defaults to employee when no role is given.
 // @Tags Admin
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Bearer token"
 // @Param id path string true "User ID"
+// @Param roleBody body model.RoleRequest false "role request body"
 // @Success 201 {object} model.SingleUserResponse
 // @Failure 400 {object} model.Status "Invalid request payload"
 // @Failure 500 {object} model.Status "Internal server error"
@@ -83,7 +96,7 @@ func (c *AdminController) GetAllUserHandler(ctx *gin.Context){
 // @Failure 403 {object} model.Status "Forbidden access"
 // @Security BearerAuth
 // @Router /user/{id}/role [patch]
-func (c *AdminController) AssignToEmployeeHandler(ctx *gin.Context) {
+func (c *AdminController) AssignRoleHandler(ctx *gin.Context) {
 	// Extract target user ID from URL parameter
 	targetUserID := ctx.Param("id")
 
@@ -92,9 +105,23 @@ func (c *AdminController) AssignToEmployeeHandler(ctx *gin.Context) {
 		shared.SendErrorResponse(ctx, http.StatusBadRequest, "user ID is required")
 		return
 	}
+
+	// Bind the optional JSON request body to User payload and handle binding errors
+	var payload entity.User
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&payload); err != nil{
+			shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	// Default to the employee role
+	if payload.Role == "" {
+		payload.Role = "employee"
+	}
 	
-	// Call the use case to assign the employee role to the specified user
-	updatedUser, err := c.uc.AssignToEmployee(targetUserID)
+	// Call the use case to assign the role to the specified user
+	updatedUser, err := c.uc.AssignRole(targetUserID, payload.Role)
 	if err != nil {
 		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
     return
@@ -132,6 +159,279 @@ func (c *AdminController) DeleteUserHandler(ctx *gin.Context){
 	shared.SendSuccessResponse(ctx, http.StatusNoContent, "successfully deleted user")
 }
 
-func NewAdminController(uc usecase.UserUseCase, rg *gin.RouterGroup) *AdminController{
-	return &AdminController{uc: uc, rg: rg}
+// @Summary Create Delivery Zone.
+// @Description Add a radius or polygon delivery zone with its fee table. Polygon points are [latitude, longitude] pairs.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param zoneBody body model.DeliveryZoneRequest true "delivery zone request body"
+// @Success 201 {object} model.SingleDeliveryZoneResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /delivery-zone [post]
+func (c *AdminController) AddDeliveryZoneHandler(ctx *gin.Context){
+	// Bind JSON request body to DeliveryZone payload and handle binding errors
+	var payload entity.DeliveryZone
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Call the usecase to create the delivery zone
+	resp, err := c.deliveryUc.CreateDeliveryZone(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with created delivery zone information
+	shared.SendCreateResponse(ctx, resp, "successfully created delivery zone")
+}
+
+// @Summary Get Delivery Zones.
+// @Description Retrieves every delivery zone with its fee table.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} model.ListDeliveryZoneResponse "Successfully retrieved delivery zones"
+// @Failure 404 {object} model.Status "No delivery zones found"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /delivery-zone [get]
+func (c *AdminController) GetDeliveryZoneHandler(ctx *gin.Context){
+	// Call the usecase to retrieve the delivery zones
+	resp, err := c.deliveryUc.GetAllDeliveryZones()
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Return not found when no zone has been set up
+	if len(resp) == 0{
+		shared.SendErrorResponse(ctx, http.StatusNotFound, "delivery zone data is empty")
+		return
+	}
+
+	// Send successfully response with the delivery zones
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved delivery zones")
+}
+
+// @Summary Delete Delivery Zone.
+// @Description Delete a delivery zone and its fee table.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Delivery Zone ID"
+// @Success 204 {object} nil "Successfully deleted delivery zone"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /delivery-zone/{id} [delete]
+func (c *AdminController) DeleteDeliveryZoneHandler(ctx *gin.Context){
+	// Extract ID from URL parameter
+	id := ctx.Param("id")
+
+	// Call the usecase to delete specified delivery zone
+	if err := c.deliveryUc.DeleteDeliveryZone(id); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send a successfully response with the provided message
+	shared.SendSuccessResponse(ctx, http.StatusNoContent, "successfully deleted delivery zone")
+}
+
+// @Summary Create Opening Hour.
+// @Description Add a weekly opening period in the restaurant's timezone. day_of_week runs from 0 (Sunday) to 6 (Saturday), a close_time before the open_time runs past midnight.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param hourBody body model.OpeningHourRequest true "opening hour request body"
+// @Success 201 {object} model.SingleOpeningHourResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /opening-hours [post]
+func (c *AdminController) AddOpeningHourHandler(ctx *gin.Context){
+	// Bind JSON request body to OpeningHour payload and handle binding errors
+	var payload entity.OpeningHour
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Call the usecase to create the opening hour
+	resp, err := c.restaurantUc.CreateOpeningHour(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with created opening hour information
+	shared.SendCreateResponse(ctx, resp, "successfully created opening hour")
+}
+
+// @Summary Get Opening Hours.
+// @Description Retrieves the weekly opening hours. Without any opening hours the restaurant is open around the clock.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} model.ListOpeningHourResponse "Successfully retrieved opening hours"
+// @Failure 404 {object} model.Status "No opening hours found"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /opening-hours [get]
+func (c *AdminController) GetOpeningHourHandler(ctx *gin.Context){
+	// Call the usecase to retrieve the opening hours
+	resp, err := c.restaurantUc.GetOpeningHours()
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Return not found when no opening hour has been set up
+	if len(resp) == 0{
+		shared.SendErrorResponse(ctx, http.StatusNotFound, "opening hour data is empty")
+		return
+	}
+
+	// Send successfully response with the opening hours
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved opening hours")
+}
+
+// @Summary Delete Opening Hour.
+// @Description Delete a weekly opening period.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Opening Hour ID"
+// @Success 204 {object} nil "Successfully deleted opening hour"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /opening-hours/{id} [delete]
+func (c *AdminController) DeleteOpeningHourHandler(ctx *gin.Context){
+	// Extract ID from URL parameter
+	id := ctx.Param("id")
+
+	// Call the usecase to delete specified opening hour
+	if err := c.restaurantUc.DeleteOpeningHour(id); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send a successfully response with the provided message
+	shared.SendSuccessResponse(ctx, http.StatusNoContent, "successfully deleted opening hour")
+}
+
+// @Summary Create Closure.
+// @Description Close the restaurant on a date, or replace that day's weekly hours with holiday hours when is_closed is false.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param closureBody body model.ClosureRequest true "closure request body"
+// @Success 201 {object} model.SingleClosureResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /closures [post]
+func (c *AdminController) AddClosureHandler(ctx *gin.Context){
+	// Bind JSON request body to RestaurantClosure payload and handle binding errors
+	var payload entity.RestaurantClosure
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Call the usecase to create the closure
+	resp, err := c.restaurantUc.CreateClosure(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with created closure information
+	shared.SendCreateResponse(ctx, resp, "successfully created closure")
+}
+
+// @Summary Get Closures.
+// @Description Retrieves today's and upcoming closures and holiday hours.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} model.ListClosureResponse "Successfully retrieved closures"
+// @Failure 404 {object} model.Status "No closures found"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /closures [get]
+func (c *AdminController) GetClosureHandler(ctx *gin.Context){
+	// Call the usecase to retrieve the upcoming closures
+	resp, err := c.restaurantUc.GetClosures()
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Return not found when no closure is planned
+	if len(resp) == 0{
+		shared.SendErrorResponse(ctx, http.StatusNotFound, "closure data is empty")
+		return
+	}
+
+	// Send successfully response with the closures
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved closures")
+}
+
+// @Summary Delete Closure.
+// @Description Delete a closure, the weekly hours apply again on that date.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Closure ID"
+// @Success 204 {object} nil "Successfully deleted closure"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /closures/{id} [delete]
+func (c *AdminController) DeleteClosureHandler(ctx *gin.Context){
+	// Extract ID from URL parameter
+	id := ctx.Param("id")
+
+	// Call the usecase to delete specified closure
+	if err := c.restaurantUc.DeleteClosure(id); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send a successfully response with the provided message
+	shared.SendSuccessResponse(ctx, http.StatusNoContent, "successfully deleted closure")
+}
+
+func NewAdminController(uc usecase.UserUseCase, deliveryUc usecase.DeliveryUseCase, restaurantUc usecase.RestaurantUseCase, rg *gin.RouterGroup) *AdminController{
+	return &AdminController{uc: uc, deliveryUc: deliveryUc, restaurantUc: restaurantUc, rg: rg}
 }
\ No newline at end of file
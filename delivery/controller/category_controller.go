@@ -0,0 +1,288 @@
+package controller
+
+import (
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+	"food-delivery-apps/shared"
+	"food-delivery-apps/usecase"
+
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CategoryController struct{
+	categoryUc usecase.CategoryUseCase
+	rg *gin.RouterGroup
+}
+
+func (c *CategoryController) Route(){
+	c.rg.POST(config.AddMenuCategory, c.AddMenuCategoryHandler)
+	c.rg.GET(config.GetMenuCategory, c.GetMenuCategoryHandler)
+	c.rg.PUT(config.UpdateMenuCategory, c.UpdateMenuCategoryHandler)
+	c.rg.DELETE(config.DeleteMenuCategory, c.DeleteMenuCategoryHandler)
+	c.rg.POST(config.AddUnitType, c.AddUnitTypeHandler)
+	c.rg.GET(config.GetUnitType, c.GetUnitTypeHandler)
+	c.rg.PUT(config.UpdateUnitType, c.UpdateUnitTypeHandler)
+	c.rg.DELETE(config.DeleteUnitType, c.DeleteUnitTypeHandler)
+}
+
+// @Summary Create Menu Category.
+// @Description Add a menu category. Categories are listed by display_order, and new categories are active unless is_active is false.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param categoryBody body model.CategoryRequest true "menu category request body"
+// @Success 201 {object} model.SingleMenuCategoryResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /menu-category [post]
+func (c *CategoryController) AddMenuCategoryHandler(ctx *gin.Context){
+	// Bind JSON request body to MenuCategory payload and handle binding errors
+	var payload entity.MenuCategory
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Call the usecase to create the category
+	resp, err := c.categoryUc.CreateMenuCategory(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with created category information
+	shared.SendCreateResponse(ctx, resp, "successfully created menu category")
+}
+
+// @Summary Get Menu Categories.
+// @Description Retrieves every menu category in display order, inactive ones included.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} model.ListMenuCategoryResponse "Successfully retrieved menu categories"
+// @Failure 404 {object} model.Status "No menu categories found"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /menu-category [get]
+func (c *CategoryController) GetMenuCategoryHandler(ctx *gin.Context){
+	// Call the usecase to retrieve the categories
+	resp, err := c.categoryUc.GetMenuCategories()
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Return not found when there are no categories
+	if len(resp) == 0{
+		shared.SendErrorResponse(ctx, http.StatusNotFound, "menu category data is empty")
+		return
+	}
+
+	// Send successfully response with the categories
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved menu categories")
+}
+
+// @Summary Update Menu Category.
+// @Description Update the name, description, display order or active flag of a menu category. Menus of an inactive category are hidden from the menu and can't be ordered.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Menu Category ID"
+// @Param categoryBody body model.CategoryRequest true "menu category request body"
+// @Success 200 {object} model.SingleMenuCategoryResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /menu-category/{id} [put]
+func (c *CategoryController) UpdateMenuCategoryHandler(ctx *gin.Context){
+	// Bind JSON request body to MenuCategory payload and handle binding errors
+	var payload entity.MenuCategory
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Extract ID from URL parameter
+	payload.Id = ctx.Param("id")
+
+	// Call the usecase to update the category
+	resp, err := c.categoryUc.UpdateMenuCategory(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with updated category information
+	shared.SendSingleResponse(ctx, resp, "successfully updated menu category")
+}
+
+// @Summary Delete Menu Category.
+// @Description Delete a menu category. A category still used by menus can't be deleted, deactivate it instead.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Menu Category ID"
+// @Success 204 {object} nil "Successfully deleted menu category"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /menu-category/{id} [delete]
+func (c *CategoryController) DeleteMenuCategoryHandler(ctx *gin.Context){
+	// Extract ID from URL parameter
+	id := ctx.Param("id")
+
+	// Call the usecase to delete specified category
+	if err := c.categoryUc.DeleteMenuCategory(id); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send a successfully response with the provided message
+	shared.SendSuccessResponse(ctx, http.StatusNoContent, "successfully deleted menu category")
+}
+
+// @Summary Create Unit Type.
+// @Description Add a unit type menus are sold in. Unit types are listed by display_order, and new unit types are active unless is_active is false.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param unitTypeBody body model.CategoryRequest true "unit type request body"
+// @Success 201 {object} model.SingleUnitTypeResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /unit-type [post]
+func (c *CategoryController) AddUnitTypeHandler(ctx *gin.Context){
+	// Bind JSON request body to UnitType payload and handle binding errors
+	var payload entity.UnitType
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Call the usecase to create the unit type
+	resp, err := c.categoryUc.CreateUnitType(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with created unit type information
+	shared.SendCreateResponse(ctx, resp, "successfully created unit type")
+}
+
+// @Summary Get Unit Types.
+// @Description Retrieves every unit type in display order, inactive ones included.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} model.ListUnitTypeResponse "Successfully retrieved unit types"
+// @Failure 404 {object} model.Status "No unit types found"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /unit-type [get]
+func (c *CategoryController) GetUnitTypeHandler(ctx *gin.Context){
+	// Call the usecase to retrieve the unit types
+	resp, err := c.categoryUc.GetUnitTypes()
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Return not found when there are no unit types
+	if len(resp) == 0{
+		shared.SendErrorResponse(ctx, http.StatusNotFound, "unit type data is empty")
+		return
+	}
+
+	// Send successfully response with the unit types
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved unit types")
+}
+
+// @Summary Update Unit Type.
+// @Description Update the name, description, display order or active flag of a unit type. Inactive unit types can't be given to menus.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Unit Type ID"
+// @Param unitTypeBody body model.CategoryRequest true "unit type request body"
+// @Success 200 {object} model.SingleUnitTypeResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /unit-type/{id} [put]
+func (c *CategoryController) UpdateUnitTypeHandler(ctx *gin.Context){
+	// Bind JSON request body to UnitType payload and handle binding errors
+	var payload entity.UnitType
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Extract ID from URL parameter
+	payload.Id = ctx.Param("id")
+
+	// Call the usecase to update the unit type
+	resp, err := c.categoryUc.UpdateUnitType(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with updated unit type information
+	shared.SendSingleResponse(ctx, resp, "successfully updated unit type")
+}
+
+// @Summary Delete Unit Type.
+// @Description Delete a unit type. A unit type still used by menus can't be deleted, deactivate it instead.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Unit Type ID"
+// @Success 204 {object} nil "Successfully deleted unit type"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /unit-type/{id} [delete]
+func (c *CategoryController) DeleteUnitTypeHandler(ctx *gin.Context){
+	// Extract ID from URL parameter
+	id := ctx.Param("id")
+
+	// Call the usecase to delete specified unit type
+	if err := c.categoryUc.DeleteUnitType(id); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send a successfully response with the provided message
+	shared.SendSuccessResponse(ctx, http.StatusNoContent, "successfully deleted unit type")
+}
+
+func NewCategoryController(categoryUc usecase.CategoryUseCase, rg *gin.RouterGroup) *CategoryController{
+	return &CategoryController{categoryUc: categoryUc, rg: rg}
+}
@@ -0,0 +1,144 @@
+package controller
+
+import (
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+	"food-delivery-apps/shared"
+	"food-delivery-apps/usecase"
+
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CourierController struct{
+	orderUc usecase.OrderUseCase
+	rg *gin.RouterGroup
+}
+
+func (c *CourierController) Route(){
+	c.rg.GET(config.GetDelivery, c.GetDeliveryHandler)
+	c.rg.PATCH(config.PickupDelivery, c.PickupDeliveryHandler)
+	c.rg.PATCH(config.CompleteDelivery, c.CompleteDeliveryHandler)
+	c.rg.PATCH(config.FailDelivery, c.FailDeliveryHandler)
+}
+
+// @Summary Get Courier's Deliveries.
+// @Description Retrieves the active deliveries assigned to the courier.
+// @Tags courier
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} model.ListOrderResponse "Successfully retrieved deliveries"
+// @Failure 404 {object} model.Status "Deliveries not found"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /delivery [get]
+func (c *CourierController) GetDeliveryHandler(ctx *gin.Context){
+	// Retrieve courierId from JWT auth middleware
+	courierId := ctx.MustGet("userID").(string)
+
+	// Call the usecase to fetch the courier's active deliveries
+	resp, err := c.orderUc.GetCourierOrders(courierId)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Check if the delivery data is empty, and if so, send a 404 Not Found response
+	if len(resp) == 0{
+		shared.SendErrorResponse(ctx, http.StatusNotFound, "no deliveries assigned")
+		return
+	}
+
+	// Send successfully response with the courier's deliveries
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved deliveries")
+}
+
+// @Summary Pick Up Delivery.
+// @Description Mark an assigned order as picked up, moving it to out for delivery.
+// @Tags courier
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Order ID"
+// @Success 200 {object} model.SingleOrderResponse
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /delivery/{id}/pickup [patch]
+func (c *CourierController) PickupDeliveryHandler(ctx *gin.Context){
+	c.updateDeliveryStatus(ctx, "out for delivery", "order picked up by courier")
+}
+
+// @Summary Complete Delivery.
+// @Description Mark an assigned order as delivered.
+// @Tags courier
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Order ID"
+// @Success 200 {object} model.SingleOrderResponse
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /delivery/{id}/deliver [patch]
+func (c *CourierController) CompleteDeliveryHandler(ctx *gin.Context){
+	c.updateDeliveryStatus(ctx, "delivered", "order delivered by courier")
+}
+
+// @Summary Fail Delivery.
+// @Description Report that an assigned order couldn't be delivered.
+// @Tags courier
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Order ID"
+// @Param noteBody body model.DeliveryNoteRequest false "delivery note request body"
+// @Success 200 {object} model.SingleOrderResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /delivery/{id}/fail [patch]
+func (c *CourierController) FailDeliveryHandler(ctx *gin.Context){
+	c.updateDeliveryStatus(ctx, "delivery failed", "delivery failed")
+}
+
+func (c *CourierController) updateDeliveryStatus(ctx *gin.Context, status, defaultNote string){
+	// Bind the optional JSON request body to OrderStatusHistory payload and handle binding errors
+	var payload entity.OrderStatusHistory
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&payload); err != nil{
+			shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	// Set id from URL parameter, courierId from JWT data and the target status
+	payload.OrderId = ctx.Param("id")
+	payload.ChangedBy = ctx.MustGet("userID").(string)
+	payload.ToStatus = status
+	if payload.Note == ""{
+		payload.Note = defaultNote
+	}
+
+	// Call the usecase to update the delivery status
+	resp, err := c.orderUc.UpdateDeliveryStatus(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with updated order information
+	shared.SendSingleResponse(ctx, resp, "successfully updated delivery status")
+}
+
+func NewCourierController(orderUc usecase.OrderUseCase, rg *gin.RouterGroup) *CourierController{
+	return &CourierController{orderUc: orderUc, rg: rg}
+}
@@ -1,7 +1,9 @@
 package controller
 
 import (
+	"errors"
 	"food-delivery-apps/config"
+	"food-delivery-apps/delivery/middleware"
 	"food-delivery-apps/entity"
 	"food-delivery-apps/shared"
 	"food-delivery-apps/usecase"
@@ -16,16 +18,36 @@ type CustomerController struct{
 	balanceUc usecase.BalanceUseCase
 	reviewUc usecase.ReviewUseCase
 	promoUc usecase.PromoUseCase
+	cartUc usecase.CartUseCase
+	addressUc usecase.AddressUseCase
+	deliveryUc usecase.DeliveryUseCase
+	idempotencyUc usecase.IdempotencyUseCase
 	rg *gin.RouterGroup
 }
 
 func (c *CustomerController) Route(){
-	c.rg.POST(config.CreateBalance, c.CreateBalanceHandler)
+	c.rg.POST(config.CreateBalance, middleware.IdempotencyMiddleware(c.idempotencyUc), c.CreateBalanceHandler)
 	c.rg.GET(config.GetBalance, c.GetBalanceDataHandler)
 	c.rg.GET(config.GetPromoCust, c.GetPromoForCustomerHandler)
-	c.rg.POST(config.AddOrder, c.AddOrderHandler)
+	c.rg.POST(config.AddOrder, middleware.IdempotencyMiddleware(c.idempotencyUc), c.AddOrderHandler)
+	c.rg.POST(config.QuoteOrder, c.QuoteOrderHandler)
 	c.rg.GET(config.GetUnfinishOrder, c.GetUnfinishOrderHandler)
+	c.rg.GET(config.OrderStream, c.OrderStreamHandler)
 	c.rg.GET(config.GetOrderHistory, c.GetOrderHistoryHandler)
+	c.rg.PATCH(config.CancelOrder, c.CancelOrderHandler)
+	c.rg.POST(config.TipOrder, middleware.IdempotencyMiddleware(c.idempotencyUc), c.TipOrderHandler)
+	c.rg.POST(config.Reorder, middleware.IdempotencyMiddleware(c.idempotencyUc), c.ReorderHandler)
+	c.rg.GET(config.GetCart, c.GetCartHandler)
+	c.rg.POST(config.AddCartItem, c.AddCartItemHandler)
+	c.rg.PUT(config.UpdateCartItem, c.UpdateCartItemHandler)
+	c.rg.DELETE(config.DeleteCartItem, c.DeleteCartItemHandler)
+	c.rg.DELETE(config.ClearCart, c.ClearCartHandler)
+	c.rg.POST(config.CheckoutCart, middleware.IdempotencyMiddleware(c.idempotencyUc), c.CheckoutCartHandler)
+	c.rg.POST(config.AddAddress, c.AddAddressHandler)
+	c.rg.GET(config.GetAddress, c.GetAddressHandler)
+	c.rg.PUT(config.UpdateAddress, c.UpdateAddressHandler)
+	c.rg.DELETE(config.DeleteAddress, c.DeleteAddressHandler)
+	c.rg.GET(config.GetDeliveryQuote, c.GetDeliveryQuoteHandler)
 	c.rg.POST(config.AddReview, c.AddReviewHandler)
 	c.rg.PUT(config.UpdateReview, c.UpdateReviewHandler)
 	c.rg.DELETE(config.DeleteReview, c.DeleteReviewHandler)
@@ -37,9 +59,11 @@ func (c *CustomerController) Route(){
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Bearer token"
+// @Param Idempotency-Key header string false "Unique key to safely retry the request, the original response is replayed"
 // @Param balanceBody body model.BalanceRequest true "balance request body"
 // @Success 201 {object} model.SingleBalanceResponse
 // @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 409 {object} model.Status "Idempotency-Key reused with a different request or still in progress"
 // @Failure 500 {object} model.Status "Internal server error"
 // @Failure 401 {object} model.Status "Unauthorized"
 // @Failure 403 {object} model.Status "Forbidden access"
@@ -168,12 +192,15 @@ func (c *CustomerController) GetPromoForCustomerHandler(ctx*gin.Context){
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Bearer token"
+// @Param Idempotency-Key header string false "Unique key to safely retry the request, the original response is replayed"
 // @Param orderBody body model.OrderRequest true "order request body"
 // @Success 201 {object} model.SingleOrderResponse
 // @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 409 {object} model.Status "Idempotency-Key reused or in progress, or an item is unavailable or sold out"
 // @Failure 500 {object} model.Status "Internal server error"
 // @Failure 401 {object} model.Status "Unauthorized"
 // @Failure 403 {object} model.Status "Forbidden access"
+// @Failure 503 {object} model.Status "The restaurant is closed, paused or busy"
 // @Security BearerAuth
 // @Router /order [post]
 func (c *CustomerController) AddOrderHandler(ctx *gin.Context){
@@ -193,7 +220,7 @@ func (c *CustomerController) AddOrderHandler(ctx *gin.Context){
 	// Call the usecase to create order for specific customer
 	resp, err := c.orderUc.CreateNewOrder(payload)
 	if err != nil{
-		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		shared.SendErrorResponse(ctx, orderErrorStatus(err), err.Error())
 		return
 	}
 	
@@ -201,13 +228,50 @@ func (c *CustomerController) AddOrderHandler(ctx *gin.Context){
 	shared.SendCreateResponse(ctx, resp, "successfully created order")
 }
 
-// @Summary Get Unfinish Customer's Order.
-// @Description Retrieves unfinish customer's order to track the order status.
+// @Summary Quote Order Price.
+// @Description Preview the price of an order without placing it: line items, subtotal, promo discount with the reason it was applied or rejected, service charge, tax, delivery fee, total and the remaining balance.
+// @Tags customer
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param orderBody body model.OrderRequest true "order request body"
+// @Success 200 {object} model.SinglePriceQuoteResponse
+// @Failure 400 {object} model.Status "Invalid request payload or no order items"
+// @Failure 409 {object} model.Status "An item is unavailable or sold out"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /order/quote [post]
+func (c *CustomerController) QuoteOrderHandler(ctx *gin.Context){
+	// Bind JSON request body to Order payload and handle binding errors
+	var payload entity.Order
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Set customerId in payload from JWT data
+	payload.CustomerId = ctx.MustGet("userID").(string)
+
+	// Call the usecase to price the order without placing it
+	resp, err := c.orderUc.QuoteOrder(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, orderErrorStatus(err), err.Error())
+		return
+	}
+
+	// Send successfully response with the price quote
+	shared.SendSingleResponse(ctx, resp, "successfully quoted order")
+}
+
+// @Summary Get Unfinish Customer's Orders.
+// @Description Retrieves the customer's active and scheduled orders to track their status, with the estimated delivery time of active orders.
 // @Tags customer
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Bearer token"
-// @Success 200 {object} model.SingleOrderResponse "Successfully retrieved customer's order"
+// @Success 200 {object} model.ListOrderResponse "Successfully retrieved customer's orders"
 // @Failure 404 {object} model.Status "unfinish order not found"
 // @Failure 500 {object} model.Status "Internal server error"
 // @Failure 401 {object} model.Status "Unauthorized"
@@ -218,15 +282,45 @@ func (c *CustomerController) GetUnfinishOrderHandler(ctx*gin.Context){
 	// Retrieve customerId from JWT auth middleware
 	customerId := ctx.MustGet("userID").(string)
 	
-	// Call the usecase to retrieve the unfinish order for specific customer
-	resp, err := c.orderUc.GetUnfinishCustomerOrder(customerId)
+	// Call the usecase to retrieve the unfinish orders for specific customer
+	resp, err := c.orderUc.GetUnfinishCustomerOrders(customerId)
 	if err != nil{
 		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	// Send Succesfully response with unfinish customer's order data
-	shared.SendSingleResponse(ctx, resp, "successfully retrieved customer's order")
+	// Return not found when the customer has no unfinish order
+	if len(resp) == 0{
+		shared.SendErrorResponse(ctx, http.StatusNotFound, "customer has no unfinish order")
+		return
+	}
+
+	// Send Succesfully response with unfinish customer's orders data
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved customer's orders")
+}
+
+// @Summary Stream Customer's Order Status.
+// @Description Streams order events (order_placed, order_status, courier_assigned, order_cancelled) for the customer's active orders as Server-Sent Events.
+// @Description EventSource clients can pass the token as the token query parameter, and resume with the Last-Event-ID header.
+// @Tags customer
+// @Produce text/event-stream
+// @Param Authorization header string false "Bearer token"
+// @Param token query string false "JWT token for EventSource clients"
+// @Param Last-Event-ID header string false "Id of the last received event"
+// @Success 200 {object} entity.OrderResponse "Stream of order events"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /order-stream [get]
+func (c *CustomerController) OrderStreamHandler(ctx *gin.Context){
+	// Retrieve customerId from JWT auth middleware
+	customerId := ctx.MustGet("userID").(string)
+
+	// Subscribe to the customer's order events, including the ones missed since Last-Event-ID
+	events, missed, unsubscribe := c.orderUc.SubscribeCustomerOrders(customerId, shared.LastEventId(ctx))
+
+	// Stream the events until the client disconnects
+	shared.SendEventStream(ctx, events, missed, unsubscribe)
 }
 
 // @Summary Get Finish Customer's Order.
@@ -281,6 +375,469 @@ func (c *CustomerController) GetOrderHistoryHandler(ctx *gin.Context){
 	shared.SendPagedResponse(ctx, interfaceSlice, paging, "successfully retrieved customer's order")
 }
 
+// @Summary Cancel Customer's Order.
+// @Description Cancel customer's own order while it is still preparing. The total price is refunded to the customer's balance.
+// @Tags customer
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Order ID"
+// @Success 200 {object} model.SingleOrderResponse
+// @Failure 409 {object} model.Status "Order can't be cancelled at this stage"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access or the order belongs to another customer"
+// @Security BearerAuth
+// @Router /order/{id}/cancel [patch]
+func (c *CustomerController) CancelOrderHandler(ctx *gin.Context){
+	// Extract ID from URL parameter
+	id := ctx.Param("id")
+	// Retrieve customerId from JWT auth middleware
+	customerId := ctx.MustGet("userID").(string)
+
+	// Call the usecase to cancel the order and refund the customer
+	resp, err := c.orderUc.CancelCustomerOrder(id, customerId)
+	if err != nil{
+		shared.SendErrorResponse(ctx, cancelErrorStatus(err), err.Error())
+		return
+	}
+
+	// Send successfully response with cancelled order information
+	shared.SendSingleResponse(ctx, resp, "successfully cancelled order")
+}
+
+// @Summary Tip Order.
+// @Description Add a tip for the courier and kitchen staff to an order, at any time until it is cancelled. The tip is debited as its own balance entry.
+// @Tags customer
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Order ID"
+// @Param Idempotency-Key header string false "Unique key to safely retry the request, the original response is replayed"
+// @Param tipBody body model.TipRequest true "tip request body"
+// @Success 200 {object} model.SingleOrderResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 409 {object} model.Status "Idempotency-Key reused with a different request or still in progress"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /order/{id}/tip [post]
+func (c *CustomerController) TipOrderHandler(ctx *gin.Context){
+	// Bind JSON request body to OrderTip payload and handle binding errors
+	var payload entity.OrderTip
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Extract ID from URL parameter and customerId from JWT auth middleware
+	id := ctx.Param("id")
+	customerId := ctx.MustGet("userID").(string)
+
+	// Call the usecase to debit the tip and add it to the order
+	resp, err := c.orderUc.TipOrder(id, customerId, payload.Amount)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the tipped order information
+	shared.SendSingleResponse(ctx, resp, "successfully tipped order")
+}
+
+// @Summary Reorder a Delivered Order.
+// @Description Place a new order with the items, address and note of a delivered order, priced at the current prices. Deleted menus and options are skipped and repriced items are reported.
+// @Tags customer
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param Idempotency-Key header string false "Unique key to safely retry the request, the original response is replayed"
+// @Param id path string true "Order ID"
+// @Success 201 {object} model.SingleReorderResponse
+// @Failure 409 {object} model.Status "Idempotency-Key reused or in progress, or an item is unavailable or sold out"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Failure 503 {object} model.Status "The restaurant is closed, paused or busy"
+// @Security BearerAuth
+// @Router /order/{id}/reorder [post]
+func (c *CustomerController) ReorderHandler(ctx *gin.Context){
+	// Extract ID from URL parameter and customerId from JWT auth middleware
+	id := ctx.Param("id")
+	customerId := ctx.MustGet("userID").(string)
+
+	// Call the usecase to place the same order again
+	resp, err := c.orderUc.Reorder(id, customerId)
+	if err != nil{
+		shared.SendErrorResponse(ctx, orderErrorStatus(err), err.Error())
+		return
+	}
+
+	// Send successfully response with the new order and the skipped or repriced items
+	shared.SendCreateResponse(ctx, resp, "successfully reordered")
+}
+
+// @Summary Get Customer's Cart.
+// @Description Retrieves the customer's cart priced at the current menu prices, flagging deleted or repriced items.
+// @Tags customer
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} model.SingleCartResponse
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /cart [get]
+func (c *CustomerController) GetCartHandler(ctx *gin.Context){
+	// Retrieve customerId from JWT auth middleware
+	customerId := ctx.MustGet("userID").(string)
+
+	// Call the usecase to retrieve the customer's cart
+	resp, err := c.cartUc.GetCart(customerId)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the cart data
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved cart")
+}
+
+// @Summary Add Item to Cart.
+// @Description Add a menu item to the customer's cart. Every combination of options is its own line, and adding the same menu with the same options increases the quantity of that line.
+// @Tags customer
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param cartBody body model.CartItemRequest true "cart item request body"
+// @Success 201 {object} model.SingleCartResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /cart [post]
+func (c *CustomerController) AddCartItemHandler(ctx *gin.Context){
+	// Bind JSON request body to CartItem payload and handle binding errors
+	var payload entity.CartItem
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Set customerId in payload from JWT data
+	payload.CustomerId = ctx.MustGet("userID").(string)
+
+	// Call the usecase to add the item to the cart
+	resp, err := c.cartUc.AddCartItem(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the updated cart
+	shared.SendCreateResponse(ctx, resp, "successfully added item to cart")
+}
+
+// @Summary Update Cart Item.
+// @Description Update the quantity or options of a line in the customer's cart. This also accepts the menu's current price.
+// @Tags customer
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Cart Item ID"
+// @Param cartBody body model.UpdateCartItemRequest true "cart item request body"
+// @Success 200 {object} model.SingleCartResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /cart/{id} [put]
+func (c *CustomerController) UpdateCartItemHandler(ctx *gin.Context){
+	// Bind JSON request body to CartItem payload and handle binding errors
+	var payload entity.CartItem
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Set the cart item id from URL parameter and customerId from JWT data
+	payload.Id = ctx.Param("id")
+	payload.CustomerId = ctx.MustGet("userID").(string)
+
+	// Call the usecase to update the cart item
+	resp, err := c.cartUc.UpdateCartItem(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the updated cart
+	shared.SendSingleResponse(ctx, resp, "successfully updated cart item")
+}
+
+// @Summary Remove Cart Item.
+// @Description Remove a line from the customer's cart.
+// @Tags customer
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Cart Item ID"
+// @Success 200 {object} model.SingleCartResponse
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /cart/{id} [delete]
+func (c *CustomerController) DeleteCartItemHandler(ctx *gin.Context){
+	// Retrieve the cart item id from URL parameter and customerId from JWT auth middleware
+	id := ctx.Param("id")
+	customerId := ctx.MustGet("userID").(string)
+
+	// Call the usecase to remove the line from the cart
+	resp, err := c.cartUc.DeleteCartItem(customerId, id)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the updated cart
+	shared.SendSingleResponse(ctx, resp, "successfully removed item from cart")
+}
+
+// @Summary Clear Cart.
+// @Description Remove every item from the customer's cart.
+// @Tags customer
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} model.Status
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /cart [delete]
+func (c *CustomerController) ClearCartHandler(ctx *gin.Context){
+	// Retrieve customerId from JWT auth middleware
+	customerId := ctx.MustGet("userID").(string)
+
+	// Call the usecase to clear the cart
+	if err := c.cartUc.ClearCart(customerId); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response
+	shared.SendSuccessResponse(ctx, http.StatusOK, "successfully cleared cart")
+}
+
+// @Summary Checkout Cart.
+// @Description Place an order from the items in the customer's cart. Repriced items must be accepted with accept_price_changes.
+// @Tags customer
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param Idempotency-Key header string false "Unique key to safely retry the request, the original response is replayed"
+// @Param checkoutBody body model.CheckoutCartRequest true "checkout request body"
+// @Success 201 {object} model.SingleOrderResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 409 {object} model.Status "Idempotency-Key reused or in progress, or an item is unavailable or sold out"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Failure 503 {object} model.Status "The restaurant is closed, paused or busy"
+// @Security BearerAuth
+// @Router /cart/checkout [post]
+func (c *CustomerController) CheckoutCartHandler(ctx *gin.Context){
+	// Bind JSON request body to CartCheckout payload and handle binding errors
+	var payload entity.CartCheckout
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Set customerId in payload from JWT data
+	payload.CustomerId = ctx.MustGet("userID").(string)
+
+	// Call the usecase to turn the cart into an order
+	resp, err := c.cartUc.Checkout(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, orderErrorStatus(err), err.Error())
+		return
+	}
+
+	// Send successfully response with created order information
+	shared.SendCreateResponse(ctx, resp, "successfully created order")
+}
+
+// @Summary Create Address.
+// @Description Save a delivery address with the coordinates picked on the client.
+// @Tags customer
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param addressBody body model.AddressRequest true "address request body"
+// @Success 201 {object} model.SingleAddressResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /address [post]
+func (c *CustomerController) AddAddressHandler(ctx *gin.Context){
+	// Bind JSON request body to CustomerAddress payload and handle binding errors
+	var payload entity.CustomerAddress
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Set customerId in payload from JWT data
+	payload.CustomerId = ctx.MustGet("userID").(string)
+
+	// Call the usecase to save the address
+	resp, err := c.addressUc.CreateAddress(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with created address information
+	shared.SendCreateResponse(ctx, resp, "successfully created address")
+}
+
+// @Summary Get Customer's Addresses.
+// @Description Retrieves the customer's saved delivery addresses.
+// @Tags customer
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} model.ListAddressResponse "Successfully retrieved addresses"
+// @Failure 404 {object} model.Status "Addresses not found"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /address [get]
+func (c *CustomerController) GetAddressHandler(ctx *gin.Context){
+	// Retrieve customerId from JWT auth middleware
+	customerId := ctx.MustGet("userID").(string)
+
+	// Call the usecase to retrieve the customer's addresses
+	resp, err := c.addressUc.GetAddresses(customerId)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Return not found when the customer has no saved address
+	if len(resp) == 0{
+		shared.SendErrorResponse(ctx, http.StatusNotFound, "address data is empty")
+		return
+	}
+
+	// Send successfully response with the customer's addresses
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved addresses")
+}
+
+// @Summary Update Address.
+// @Description Update a saved delivery address and its coordinates.
+// @Tags customer
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Address ID"
+// @Param addressBody body model.AddressRequest true "address request body"
+// @Success 200 {object} model.SingleAddressResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /address/{id} [put]
+func (c *CustomerController) UpdateAddressHandler(ctx *gin.Context){
+	// Bind JSON request body to CustomerAddress payload and handle binding errors
+	var payload entity.CustomerAddress
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Set id from URL parameter and customerId from JWT data
+	payload.Id = ctx.Param("id")
+	payload.CustomerId = ctx.MustGet("userID").(string)
+
+	// Call the usecase to update the address
+	resp, err := c.addressUc.UpdateAddress(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with updated address information
+	shared.SendSingleResponse(ctx, resp, "successfully updated address")
+}
+
+// @Summary Delete Address.
+// @Description Delete a saved delivery address. Past orders keep their address text.
+// @Tags customer
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Address ID"
+// @Success 204 {object} nil "Successfully deleted address"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /address/{id} [delete]
+func (c *CustomerController) DeleteAddressHandler(ctx *gin.Context){
+	// Extract ID from URL parameter and customerId from JWT auth middleware
+	id := ctx.Param("id")
+	customerId := ctx.MustGet("userID").(string)
+
+	// Call the usecase to delete specified address
+	if err := c.addressUc.DeleteAddress(id, customerId); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send a successfully response with the provided message
+	shared.SendSuccessResponse(ctx, http.StatusNoContent, "successfully deleted address")
+}
+
+// @Summary Get Delivery Fee.
+// @Description Quote the delivery fee to a saved address from the delivery zone covering it.
+// @Tags customer
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Address ID"
+// @Success 200 {object} model.SingleDeliveryQuoteResponse
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /address/{id}/delivery-fee [get]
+func (c *CustomerController) GetDeliveryQuoteHandler(ctx *gin.Context){
+	// Extract ID from URL parameter and customerId from JWT auth middleware
+	id := ctx.Param("id")
+	customerId := ctx.MustGet("userID").(string)
+
+	// Call the usecase to price the delivery to the address
+	resp, err := c.deliveryUc.GetDeliveryQuote(customerId, id)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the delivery quote
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved delivery fee")
+}
+
 // @Summary Create Review.
 // @Description add review (1-5) for specific menu by specific customer.
 // @Tags customer
@@ -394,6 +951,34 @@ func (c *CustomerController) DeleteReviewHandler(ctx*gin.Context){
 	shared.SendSuccessResponse(ctx, http.StatusNoContent, "successfully deleted review")
 }
 
-func NewCustomerController(orderUc usecase.OrderUseCase, balanceUc usecase.BalanceUseCase, reviewUc usecase.ReviewUseCase, promoUc usecase.PromoUseCase, rg *gin.RouterGroup) *CustomerController{
-	return &CustomerController{orderUc: orderUc, balanceUc: balanceUc, reviewUc: reviewUc, promoUc: promoUc, rg: rg}
+// orderErrorStatus answers 503 when the restaurant can't take the order right now, so clients know to retry later,
+// 409 when an ordered item is unavailable or not enough of it is left, and 400 for an order without items
+func orderErrorStatus(err error) int{
+	if errors.Is(err, config.ErrEmptyOrder){
+		return http.StatusBadRequest
+	}
+	if errors.Is(err, config.ErrRestaurantBusy) || errors.Is(err, config.ErrOrderingPaused) || errors.Is(err, config.ErrRestaurantClosed){
+		return http.StatusServiceUnavailable
+	}
+	if errors.Is(err, config.ErrNotEnoughStock) || errors.Is(err, config.ErrMenuUnavailable){
+		return http.StatusConflict
+	}
+
+	return http.StatusInternalServerError
+}
+
+// cancelErrorStatus picks the HTTP status for an error from cancelling an order
+func cancelErrorStatus(err error) int{
+	if errors.Is(err, config.ErrOrderNotOwned){
+		return http.StatusForbidden
+	}
+	if errors.Is(err, config.ErrOrderNotCancellable){
+		return http.StatusConflict
+	}
+
+	return http.StatusInternalServerError
+}
+
+func NewCustomerController(orderUc usecase.OrderUseCase, balanceUc usecase.BalanceUseCase, reviewUc usecase.ReviewUseCase, promoUc usecase.PromoUseCase, cartUc usecase.CartUseCase, addressUc usecase.AddressUseCase, deliveryUc usecase.DeliveryUseCase, idempotencyUc usecase.IdempotencyUseCase, rg *gin.RouterGroup) *CustomerController{
+	return &CustomerController{orderUc: orderUc, balanceUc: balanceUc, reviewUc: reviewUc, promoUc: promoUc, cartUc: cartUc, addressUc: addressUc, deliveryUc: deliveryUc, idempotencyUc: idempotencyUc, rg: rg}
 }
\ No newline at end of file
@@ -4,8 +4,10 @@ import (
 	"food-delivery-apps/config"
 	"food-delivery-apps/entity"
 	"food-delivery-apps/shared"
+	"food-delivery-apps/shared/model"
 	"food-delivery-apps/usecase"
 
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -24,11 +26,26 @@ func (c *EmployeeController) Route(){
 	c.rg.POST(config.AddMenu, c.AddMenuHandler)
 	c.rg.PUT(config.UpdateMenu, c.UpdateMenuHandler)
 	c.rg.DELETE(config.DeleteMenu, c.DeleteMenuHandler)
+	c.rg.GET(config.GetArchivedMenu, c.GetArchivedMenuHandler)
+	c.rg.PATCH(config.RestoreMenu, c.RestoreMenuHandler)
+	c.rg.PUT(config.UpdateMenuStock, c.UpdateMenuStockHandler)
+	c.rg.PATCH(config.MenuAvailability, c.MenuAvailabilityHandler)
+	c.rg.PUT(config.UploadMenuImage, c.UploadMenuImageHandler)
+	c.rg.DELETE(config.DeleteMenuImage, c.DeleteMenuImageHandler)
+	c.rg.POST(config.AddModifierGroup, c.AddModifierGroupHandler)
+	c.rg.DELETE(config.DeleteModifierGroup, c.DeleteModifierGroupHandler)
 	c.rg.POST(config.AddPromo, c.AddPromoHandler)
 	c.rg.GET(config.GetPromo, c.GetPromoHandler)
 	c.rg.DELETE(config.DeletePromo, c.DeletePromoHandler)
 	c.rg.GET(config.GetAllOrder, c.GetAllOrderHandler)
 	c.rg.PATCH(config.UpdateOrderStatus, c.UpdateOrderStatusHandler)
+	c.rg.PATCH(config.CancelOrderStatus, c.CancelOrderHandler)
+	c.rg.PATCH(config.AssignCourier, c.AssignCourierHandler)
+	c.rg.GET(config.KitchenStream, c.KitchenStreamHandler)
+	c.rg.GET(config.KitchenQueue, c.KitchenQueueHandler)
+	c.rg.PATCH(config.MarkOrderItemDone, c.MarkOrderItemDoneHandler)
+	c.rg.GET(config.KitchenCapacity, c.KitchenCapacityHandler)
+	c.rg.PUT(config.OrderingPause, c.OrderingPauseHandler)
 }
 
 // @Summary Create Menu.
@@ -114,14 +131,14 @@ func (c *EmployeeController) UpdateMenuHandler(ctx *gin.Context){
 	shared.SendSingleResponse(ctx, resp, "successfully updated menu")
 }
 
-// @Summary Delete Menu.
-// @Description Delete an existing menu.
+// @Summary Archive Menu.
+// @Description Archive a menu. It disappears from the public menu and can't be ordered, but stays in order history, reviews and reports.
 // @Tags employee
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Bearer token"
 // @Param id path string true "Menu ID"
-// @Success 204 {object} nil "Successfully deleted menu"
+// @Success 204 {object} nil "Successfully archived menu"
 // @Failure 500 {object} model.Status "Internal server error"
 // @Failure 401 {object} model.Status "Unauthorized"
 // @Failure 403 {object} model.Status "Forbidden access"
@@ -131,15 +148,290 @@ func (c *EmployeeController) DeleteMenuHandler(ctx *gin.Context){
 	// Extract ID from URL parameter
 	id := ctx.Param("id")
 	
-	// Call the usecase to delete specified menu
-	err := c.menuUc.DeleteMenu(id)
+	// Call the usecase to archive specified menu
+	err := c.menuUc.ArchiveMenu(id)
 	if err != nil{
 		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	// Send successfully response with the provide message
-	shared.SendSuccessResponse(ctx, http.StatusNoContent, "successfully deleted menu")
+	shared.SendSuccessResponse(ctx, http.StatusNoContent, "successfully archived menu")
+}
+
+// @Summary Get Archived Menus.
+// @Description Retrieves the archived menus, most recently archived first.
+// @Tags employee
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} model.ListMenuResponse "Successfully retrieved archived menus"
+// @Failure 404 {object} model.Status "No archived menus found"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /menu/archived [get]
+func (c *EmployeeController) GetArchivedMenuHandler(ctx *gin.Context){
+	// Call the usecase to retrieve the archived menus
+	resp, err := c.menuUc.GetArchivedMenus()
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Return not found when no menu is archived
+	if len(resp) == 0{
+		shared.SendErrorResponse(ctx, http.StatusNotFound, "no archived menus found")
+		return
+	}
+
+	// Send successfully response with the archived menus
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved archived menus")
+}
+
+// @Summary Restore Menu.
+// @Description Restore an archived menu, so it shows on the public menu and can be ordered again.
+// @Tags employee
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Menu ID"
+// @Success 200 {object} model.SingleMenuResponse "Successfully restored menu"
+// @Failure 409 {object} model.Status "An active menu already uses the name or description"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /menu/{id}/restore [patch]
+func (c *EmployeeController) RestoreMenuHandler(ctx *gin.Context){
+	// Extract ID from URL parameter
+	id := ctx.Param("id")
+
+	// Call the usecase to restore specified menu
+	resp, err := c.menuUc.RestoreMenu(id)
+	if err != nil{
+		status := http.StatusInternalServerError
+		if errors.Is(err, config.ErrMenuConflict){
+			status = http.StatusConflict
+		}
+		shared.SendErrorResponse(ctx, status, err.Error())
+		return
+	}
+
+	// Send successfully response with the restored menu
+	shared.SendSingleResponse(ctx, resp, "successfully restored menu")
+}
+
+// @Summary Update Menu Stock.
+// @Description Set the daily stock of a menu item and optionally how much of it is left today. The stock is reset to daily_stock every midnight, and leaving daily_stock empty stops tracking the item.
+// @Tags employee
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Menu ID"
+// @Param stockBody body model.MenuStockRequest true "menu stock request body"
+// @Success 200 {object} model.SingleMenuResponse "Successfully updated menu stock"
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /menu/{id}/stock [put]
+func (c *EmployeeController) UpdateMenuStockHandler(ctx *gin.Context){
+	// Bind JSON request body to MenuStock payload and handle binding errors
+	var payload entity.MenuStock
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Extract ID from URL parameter
+	payload.MenuId = ctx.Param("id")
+
+	// Call the usecase to update the menu stock
+	resp, err := c.menuUc.UpdateMenuStock(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the updated menu
+	shared.SendSingleResponse(ctx, resp, "successfully updated menu stock")
+}
+
+// @Summary Upload Menu Image.
+// @Description Upload the picture of a menu item as multipart form data, replacing the previous one. The content must be a jpeg, png or gif image within MENU_IMAGE_MAX_SIZE_KB and MENU_IMAGE_MAX_PIXELS, and small and medium thumbnails are made from it.
+// @Tags employee
+// @Accept multipart/form-data
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Menu ID"
+// @Param image formData file true "menu image"
+// @Success 200 {object} model.SingleMenuResponse "Successfully uploaded menu image"
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 413 {object} model.Status "Image is too large"
+// @Failure 415 {object} model.Status "Image type is not supported"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /menu/{id}/image [put]
+func (c *EmployeeController) UploadMenuImageHandler(ctx *gin.Context){
+	// Retrieve the uploaded file from the multipart form
+	fileHeader, err := ctx.FormFile("image")
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, fmt.Sprintf("image file is required: %v", err.Error()))
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	defer file.Close()
+
+	// Call the usecase to store the image and its thumbnails
+	resp, err := c.menuUc.UploadMenuImage(ctx.Param("id"), fileHeader.Size, file)
+	if err != nil{
+		status := http.StatusInternalServerError
+		if errors.Is(err, config.ErrImageTooLarge){
+			status = http.StatusRequestEntityTooLarge
+		} else if errors.Is(err, config.ErrUnsupportedImageType){
+			status = http.StatusUnsupportedMediaType
+		}
+		shared.SendErrorResponse(ctx, status, err.Error())
+		return
+	}
+
+	// Send successfully response with the menu and its image URLs
+	shared.SendSingleResponse(ctx, resp, "successfully uploaded menu image")
+}
+
+// @Summary Delete Menu Image.
+// @Description Remove the picture of a menu item together with its thumbnails.
+// @Tags employee
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Menu ID"
+// @Success 200 {object} model.SingleMenuResponse "Successfully deleted menu image"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /menu/{id}/image [delete]
+func (c *EmployeeController) DeleteMenuImageHandler(ctx *gin.Context){
+	// Call the usecase to remove the image of the menu
+	resp, err := c.menuUc.DeleteMenuImage(ctx.Param("id"))
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the updated menu
+	shared.SendSingleResponse(ctx, resp, "successfully deleted menu image")
+}
+
+// @Summary Set Menu Availability.
+// @Description Mark a menu item as unavailable, or available again, without archiving it. Unavailable items stay on the public menu but can't be ordered.
+// @Tags employee
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Menu ID"
+// @Param availabilityBody body model.MenuAvailabilityRequest true "menu availability request body"
+// @Success 200 {object} model.SingleMenuResponse "Successfully updated menu availability"
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /menu/{id}/availability [patch]
+func (c *EmployeeController) MenuAvailabilityHandler(ctx *gin.Context){
+	// Bind JSON request body to MenuAvailability payload and handle binding errors
+	var payload entity.MenuAvailability
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Extract ID from URL parameter
+	payload.MenuId = ctx.Param("id")
+
+	// Call the usecase to update the menu availability
+	resp, err := c.menuUc.SetMenuAvailability(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the updated menu
+	shared.SendSingleResponse(ctx, resp, "successfully updated menu availability")
+}
+
+// @Summary Create Modifier Group.
+// @Description Add a modifier group (e.g. size, add-ons, spice level) with its options to a menu.
+// @Tags employee
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Menu ID"
+// @Param modifierBody body model.ModifierGroupRequest true "modifier group request body"
+// @Success 201 {object} model.SingleModifierGroupResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /menu/{id}/modifier-group [post]
+func (c *EmployeeController) AddModifierGroupHandler(ctx *gin.Context){
+	// Bind JSON request body to ModifierGroup payload and handle binding errors
+	var payload entity.ModifierGroup
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Set menuId in payload from URL parameter
+	payload.MenuId = ctx.Param("id")
+
+	// Call the usecase to create the modifier group
+	resp, err := c.menuUc.CreateModifierGroup(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with created modifier group information
+	shared.SendCreateResponse(ctx, resp, "successfully created modifier group")
+}
+
+// @Summary Delete Modifier Group.
+// @Description Delete a modifier group and its options.
+// @Tags employee
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Modifier Group ID"
+// @Success 204 {object} nil "Successfully deleted modifier group"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /modifier-group/{id} [delete]
+func (c *EmployeeController) DeleteModifierGroupHandler(ctx *gin.Context){
+	// Extract ID from URL parameter
+	id := ctx.Param("id")
+
+	// Call the usecase to delete specified modifier group
+	if err := c.menuUc.DeleteModifierGroup(id); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the provide message
+	shared.SendSuccessResponse(ctx, http.StatusNoContent, "successfully deleted modifier group")
 }
 
 // @Summary Create Promo.
@@ -253,7 +545,7 @@ func (c *EmployeeController) DeletePromoHandler(ctx *gin.Context){
 }
 
 // @Summary Get Order.
-// @Description Retrieves a paginated list of all customer's order. filter status with 'finish' or 'unfinish'
+// @Description Retrieves a paginated list of all customer's order. filter status with 'finish', 'unfinish', 'scheduled' or 'cancelled'
 // @Tags employee
 // @Accept json
 // @Produce json
@@ -298,12 +590,13 @@ func (c *EmployeeController) GetAllOrderHandler(ctx *gin.Context){
 }
 
 // @Summary Update Order Status.
-// @Description Update an existing customer's order status.
+// @Description Move a customer's order to its next status. Without a body the order moves to its default next stage.
 // @Tags employee
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Bearer token"
 // @Param id path string true "Order ID"
+// @Param statusBody body model.UpdateOrderStatusRequest false "order status request body"
 // @Success 201 {object} model.SingleOrderResponse
 // @Failure 400 {object} model.Status "Invalid request payload"
 // @Failure 500 {object} model.Status "Internal server error"
@@ -314,16 +607,20 @@ func (c *EmployeeController) GetAllOrderHandler(ctx *gin.Context){
 func (c *EmployeeController) UpdateOrderStatusHandler(ctx *gin.Context){
 	// Extract ID from URL parameter
 	id := ctx.Param("id")
+	// Retrieve employeeId from JWT auth middleware
+	employeeId := ctx.MustGet("userID").(string)
 
-	// Bind JSON request body to OrderResponse payload and handle binding errors
-	var payload entity.OrderResponse
+	// Bind JSON request body to OrderStatusHistory payload and handle binding errors
+	var payload entity.OrderStatusHistory
 	if err := ctx.ShouldBind(&payload); err != nil{
 		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
 		return
 	}
 
 	// Set id in payload from URL parameter
-	payload.Id = id
+	payload.OrderId = id
+	// Set employeeId in payload from JWT data
+	payload.ChangedBy = employeeId
 
 	// Call the usecase to update the order status by id
 	resp, err := c.orderUc.UpdateOrderStatus(payload)
@@ -336,6 +633,229 @@ func (c *EmployeeController) UpdateOrderStatusHandler(ctx *gin.Context){
 	shared.SendSingleResponse(ctx, resp, "successfully updated order status")
 }
 
+// @Summary Cancel Order.
+// @Description Cancel a customer's order at any stage with a reason. The total price is refunded to the customer's balance.
+// @Tags employee
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Order ID"
+// @Param cancelBody body model.CancelOrderRequest true "cancel request body"
+// @Success 200 {object} model.SingleOrderResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 409 {object} model.Status "Order can't be cancelled at this stage"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /order-status/{id}/cancel [patch]
+func (c *EmployeeController) CancelOrderHandler(ctx *gin.Context){
+	// Extract ID from URL parameter
+	id := ctx.Param("id")
+	// Retrieve employeeId from JWT auth middleware
+	employeeId := ctx.MustGet("userID").(string)
+
+	// Bind JSON request body to CancelOrderRequest payload and handle binding errors
+	var payload model.CancelOrderRequest
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Call the usecase to cancel the order and refund the customer
+	resp, err := c.orderUc.CancelOrderByEmployee(id, employeeId, payload.CancelReason)
+	if err != nil{
+		shared.SendErrorResponse(ctx, cancelErrorStatus(err), err.Error())
+		return
+	}
+
+	// Send successfully response with cancelled order information
+	shared.SendSingleResponse(ctx, resp, "successfully cancelled order")
+}
+
+// @Summary Assign Courier.
+// @Description Assign an order that is ready for pickup to a courier. Without a courier id the least-loaded courier is picked.
+// @Tags employee
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Order ID"
+// @Param courierBody body model.AssignCourierRequest false "courier request body"
+// @Success 200 {object} model.SingleOrderResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /order/{id}/courier [patch]
+func (c *EmployeeController) AssignCourierHandler(ctx *gin.Context){
+	// Extract ID from URL parameter
+	id := ctx.Param("id")
+	// Retrieve employeeId from JWT auth middleware
+	employeeId := ctx.MustGet("userID").(string)
+
+	// Bind the optional JSON request body to Order payload and handle binding errors
+	var payload entity.Order
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&payload); err != nil{
+			shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	// Call the usecase to assign the order to a courier
+	resp, err := c.orderUc.AssignCourier(id, payload.CourierId, employeeId)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with assigned order information
+	shared.SendSingleResponse(ctx, resp, "successfully assigned courier")
+}
+
+// @Summary Stream Kitchen Queue.
+// @Description Streams newly placed orders and order status changes as Server-Sent Events for the kitchen queue.
+// @Description EventSource clients can pass the token as the token query parameter, and resume with the Last-Event-ID header.
+// @Tags employee
+// @Produce text/event-stream
+// @Param Authorization header string false "Bearer token"
+// @Param token query string false "JWT token for EventSource clients"
+// @Param Last-Event-ID header string false "Id of the last received event"
+// @Success 200 {object} entity.OrderResponse "Stream of order events"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /kitchen-stream [get]
+func (c *EmployeeController) KitchenStreamHandler(ctx *gin.Context){
+	// Subscribe to the kitchen order events, including the ones missed since Last-Event-ID
+	events, missed, unsubscribe := c.orderUc.SubscribeKitchenOrders(shared.LastEventId(ctx))
+
+	// Stream the events until the client disconnects
+	shared.SendEventStream(ctx, events, missed, unsubscribe)
+}
+
+// @Summary Get Kitchen Queue.
+// @Description Retrieves the confirmed and preparing orders in the order the kitchen works on them, with the expected ready time of each order based on its unfinished items and the orders ahead of it.
+// @Tags employee
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} model.ListKitchenQueueResponse "Successfully retrieved kitchen queue"
+// @Failure 404 {object} model.Status "Kitchen queue is empty"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /kitchen-queue [get]
+func (c *EmployeeController) KitchenQueueHandler(ctx *gin.Context){
+	// Call the usecase to retrieve the kitchen queue with expected ready times
+	resp, err := c.orderUc.GetKitchenQueue()
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Return not found when no order is waiting in the kitchen
+	if len(resp) == 0{
+		shared.SendErrorResponse(ctx, http.StatusNotFound, "kitchen queue is empty")
+		return
+	}
+
+	// Send successfully response with the kitchen queue
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved kitchen queue")
+}
+
+// @Summary Mark Order Item as Done.
+// @Description Mark an item of a confirmed or preparing order as done, so it no longer counts towards the order's expected ready time.
+// @Tags employee
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Order ID"
+// @Param itemId path string true "Order Item ID"
+// @Success 200 {object} model.SingleOrderResponse
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /order/{id}/items/{itemId}/done [patch]
+func (c *EmployeeController) MarkOrderItemDoneHandler(ctx *gin.Context){
+	// Extract the order and item ID from URL parameter
+	id := ctx.Param("id")
+	itemId := ctx.Param("itemId")
+
+	// Call the usecase to mark the item as done
+	resp, err := c.orderUc.MarkOrderItemDone(id, itemId)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the updated order information
+	shared.SendSingleResponse(ctx, resp, "successfully marked order item as done")
+}
+
+// @Summary Get Kitchen Capacity.
+// @Description Retrieves the kitchen load against its limits, whether ordering is paused, and the first scheduled slot offered to customers while the kitchen is busy.
+// @Tags employee
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} model.SingleKitchenCapacityResponse
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /kitchen-capacity [get]
+func (c *EmployeeController) KitchenCapacityHandler(ctx *gin.Context){
+	// Call the usecase to retrieve the kitchen capacity
+	resp, err := c.orderUc.GetKitchenCapacity()
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the kitchen capacity
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved kitchen capacity")
+}
+
+// @Summary Pause or Resume Ordering.
+// @Description Manually pause new orders with an optional reason shown to customers, or resume them.
+// @Tags employee
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param pauseBody body model.OrderingPauseRequest true "ordering pause request body"
+// @Success 200 {object} model.SingleKitchenCapacityResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /ordering-pause [put]
+func (c *EmployeeController) OrderingPauseHandler(ctx *gin.Context){
+	// Bind JSON request body to RestaurantSettings payload and handle binding errors
+	var payload entity.RestaurantSettings
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Set employeeId in payload from JWT data
+	payload.UpdatedBy = ctx.MustGet("userID").(string)
+
+	// Call the usecase to pause or resume ordering
+	resp, err := c.orderUc.SetOrderingPause(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the kitchen capacity
+	shared.SendSingleResponse(ctx, resp, "successfully updated ordering pause")
+}
+
 func NewEmployeeController(menuUc usecase.MenuUseCase, orderUc usecase.OrderUseCase, promoUc usecase.PromoUseCase, rg *gin.RouterGroup) *EmployeeController{
 	return &EmployeeController{menuUc: menuUc, orderUc: orderUc, PromoUc: promoUc, rg: rg}
 }
\ No newline at end of file
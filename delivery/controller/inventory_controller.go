@@ -0,0 +1,337 @@
+package controller
+
+import (
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+	"food-delivery-apps/shared"
+	"food-delivery-apps/usecase"
+
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InventoryController struct{
+	inventoryUc usecase.InventoryUseCase
+	rg *gin.RouterGroup
+}
+
+func (c *InventoryController) Route(){
+	c.rg.POST(config.AddIngredient, c.AddIngredientHandler)
+	c.rg.GET(config.GetIngredient, c.GetIngredientHandler)
+	c.rg.PUT(config.UpdateIngredient, c.UpdateIngredientHandler)
+	c.rg.POST(config.IngredientReceipt, c.IngredientReceiptHandler)
+	c.rg.POST(config.IngredientAdjustment, c.IngredientAdjustmentHandler)
+	c.rg.GET(config.IngredientMovement, c.IngredientMovementHandler)
+	c.rg.GET(config.LowStockIngredient, c.LowStockIngredientHandler)
+	c.rg.GET(config.GetRecipe, c.GetRecipeHandler)
+	c.rg.PUT(config.SetRecipe, c.SetRecipeHandler)
+}
+
+// @Summary Create Ingredient.
+// @Description Add an ingredient to the catalogue with its unit, opening quantity on hand and reorder threshold.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param ingredientBody body model.IngredientRequest true "ingredient request body"
+// @Success 201 {object} model.SingleIngredientResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /ingredient [post]
+func (c *InventoryController) AddIngredientHandler(ctx *gin.Context){
+	// Retrieve employee ID from the context
+	createdBy := ctx.MustGet("userID").(string)
+
+	// Bind JSON request body to Ingredient payload and handle binding errors
+	var payload entity.Ingredient
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Call the usecase to create the ingredient
+	resp, err := c.inventoryUc.CreateIngredient(payload, createdBy)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with created ingredient information
+	shared.SendCreateResponse(ctx, resp, "successfully created ingredient")
+}
+
+// @Summary Get Ingredients.
+// @Description Retrieves every ingredient with its quantity on hand. is_low marks ingredients at or below their reorder threshold.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} model.ListIngredientResponse "Successfully retrieved ingredients"
+// @Failure 404 {object} model.Status "No ingredients found"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /ingredient [get]
+func (c *InventoryController) GetIngredientHandler(ctx *gin.Context){
+	// Call the usecase to retrieve the ingredients
+	resp, err := c.inventoryUc.GetIngredients()
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Return not found when the catalogue is empty
+	if len(resp) == 0{
+		shared.SendErrorResponse(ctx, http.StatusNotFound, "ingredient data is empty")
+		return
+	}
+
+	// Send successfully response with the ingredients
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved ingredients")
+}
+
+// @Summary Update Ingredient.
+// @Description Update the name, unit or reorder threshold of an ingredient. The quantity on hand only changes through receipts, adjustments and delivered orders.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Ingredient ID"
+// @Param ingredientBody body model.UpdateIngredientRequest true "ingredient request body"
+// @Success 200 {object} model.SingleIngredientResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /ingredient/{id} [put]
+func (c *InventoryController) UpdateIngredientHandler(ctx *gin.Context){
+	// Bind JSON request body to Ingredient payload and handle binding errors
+	var payload entity.Ingredient
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Extract ID from URL parameter
+	payload.Id = ctx.Param("id")
+
+	// Call the usecase to update the ingredient
+	resp, err := c.inventoryUc.UpdateIngredient(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with updated ingredient information
+	shared.SendSingleResponse(ctx, resp, "successfully updated ingredient")
+}
+
+// @Summary Record Stock Receipt.
+// @Description Add a delivery from a supplier to the quantity on hand.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Ingredient ID"
+// @Param movementBody body model.StockMovementRequest true "stock receipt request body"
+// @Success 201 {object} model.SingleIngredientResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /ingredient/{id}/receipt [post]
+func (c *InventoryController) IngredientReceiptHandler(ctx *gin.Context){
+	c.recordStockMovement(ctx, "receipt", "successfully recorded stock receipt")
+}
+
+// @Summary Record Stock Adjustment.
+// @Description Correct the quantity on hand after waste, spoilage or a stocktake. A negative quantity takes stock out, and a note with the reason is required.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Ingredient ID"
+// @Param movementBody body model.StockMovementRequest true "stock adjustment request body"
+// @Success 201 {object} model.SingleIngredientResponse
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /ingredient/{id}/adjustment [post]
+func (c *InventoryController) IngredientAdjustmentHandler(ctx *gin.Context){
+	c.recordStockMovement(ctx, "adjustment", "successfully recorded stock adjustment")
+}
+
+func (c *InventoryController) recordStockMovement(ctx *gin.Context, movementType, message string){
+	// Bind JSON request body to StockMovement payload and handle binding errors
+	var payload entity.StockMovement
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Extract ID from URL parameter and the employee ID from the context
+	payload.IngredientId = ctx.Param("id")
+	payload.MovementType = movementType
+	payload.CreatedBy = ctx.MustGet("userID").(string)
+
+	// Call the usecase to record the stock movement
+	resp, err := c.inventoryUc.RecordStockMovement(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the updated ingredient
+	shared.SendCreateResponse(ctx, resp, message)
+}
+
+// @Summary Get Stock Movements.
+// @Description Retrieves a paginated list of an ingredient's receipts, adjustments and consumption by delivered orders, latest first.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Ingredient ID"
+// @Param page query int false "Page number" default(1)
+// @Param size query int false "Number of items per page" default(10)
+// @Success 200 {object} model.PagedStockMovementResponse "Successfully retrieved stock movements"
+// @Failure 404 {object} model.Status "No stock movements found"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /ingredient/{id}/movements [get]
+func (c *InventoryController) IngredientMovementHandler(ctx *gin.Context){
+	// Set default pagination parameters (page and size)
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	size, _ := strconv.Atoi(ctx.DefaultQuery("size", "10"))
+
+	// Call the usecase to fetch the movements with pagination
+	resp, paging, err := c.inventoryUc.GetStockMovements(ctx.Param("id"), page, size)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Convert movement data to a slice of empty interfaces for generic handling
+	var interfaceSlice = make([]interface{}, len(resp))
+	for i, v := range resp{
+		interfaceSlice[i] = v
+	}
+
+	// Check if the movement data is empty, and if so, send a 404 Not Found response
+	if len(interfaceSlice) == 0{
+		shared.SendErrorResponse(ctx, http.StatusNotFound, "no stock movements found")
+		return
+	}
+
+	// Send paged response with movement data and pagination details
+	shared.SendPagedResponse(ctx, interfaceSlice, paging, "successfully retrieved stock movements")
+}
+
+// @Summary Get Low Stock Ingredients.
+// @Description Retrieves the ingredients at or below their reorder threshold with the menu items that use them.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} model.ListLowStockIngredientResponse "Successfully retrieved low stock ingredients"
+// @Failure 404 {object} model.Status "No ingredients are low on stock"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /ingredient/low-stock [get]
+func (c *InventoryController) LowStockIngredientHandler(ctx *gin.Context){
+	// Call the usecase to retrieve the low stock ingredients
+	resp, err := c.inventoryUc.GetLowStockIngredients()
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Return not found when every ingredient is above its threshold
+	if len(resp) == 0{
+		shared.SendErrorResponse(ctx, http.StatusNotFound, "no ingredients are low on stock")
+		return
+	}
+
+	// Send successfully response with the low stock ingredients
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved low stock ingredients")
+}
+
+// @Summary Get Recipe.
+// @Description Retrieves the bill of materials of a menu item: the quantity of each ingredient one unit uses.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Menu ID"
+// @Success 200 {object} model.SingleRecipeResponse "Successfully retrieved recipe"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /menu/{id}/recipe [get]
+func (c *InventoryController) GetRecipeHandler(ctx *gin.Context){
+	// Call the usecase to retrieve the recipe of the menu
+	resp, err := c.inventoryUc.GetRecipe(ctx.Param("id"))
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the recipe
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved recipe")
+}
+
+// @Summary Set Recipe.
+// @Description Replace the bill of materials of a menu item. Delivered orders consume these quantities per unit sold, and an empty list clears the recipe.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Menu ID"
+// @Param recipeBody body model.RecipeRequest true "recipe request body"
+// @Success 200 {object} model.SingleRecipeResponse "Successfully updated recipe"
+// @Failure 400 {object} model.Status "Invalid request payload"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /menu/{id}/recipe [put]
+func (c *InventoryController) SetRecipeHandler(ctx *gin.Context){
+	// Bind JSON request body to Recipe payload and handle binding errors
+	var payload entity.Recipe
+	if err := ctx.ShouldBindJSON(&payload); err != nil{
+		shared.SendErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Extract ID from URL parameter
+	payload.MenuId = ctx.Param("id")
+
+	// Call the usecase to replace the recipe
+	resp, err := c.inventoryUc.SetRecipe(payload)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the updated recipe
+	shared.SendSingleResponse(ctx, resp, "successfully updated recipe")
+}
+
+func NewInventoryController(inventoryUc usecase.InventoryUseCase, rg *gin.RouterGroup) *InventoryController{
+	return &InventoryController{inventoryUc: inventoryUc, rg: rg}
+}
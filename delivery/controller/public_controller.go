@@ -1,7 +1,9 @@
 package controller
 
 import (
+	"errors"
 	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
 	"food-delivery-apps/shared"
 	"food-delivery-apps/usecase"
 
@@ -14,24 +16,31 @@ import (
 
 type PublicController struct{
 	menuUc usecase.MenuUseCase
+	categoryUc usecase.CategoryUseCase
 	reviewUc usecase.ReviewUseCase
+	restaurantUc usecase.RestaurantUseCase
 	rg *gin.RouterGroup
 }
 
 func (c *PublicController) Route(){
 	c.rg.GET(config.GetMenu, c.GetMenuHandler)
+	c.rg.GET(config.GetActiveMenuCategory, c.GetMenuCategoryHandler)
 	c.rg.GET(config.GetReview, c.GetReviewHandler)
+	c.rg.GET(config.StoreStatus, c.GetStoreStatusHandler)
 }
 
 
 // @Summary Get Menus
-// @Description Retrieves a paginated list of menus. You can filter by type or name.
+// @Description Retrieves a paginated list of menus with the restaurant's current open or closed status. You can filter by category or name, and group the page by category. The category filter takes the id or the name of an active category, and type is kept as an alias of category.
 // @Tags Public
 // @Param page query int false "Page number" default(1)
 // @Param size query int false "Number of items per page" default(10)
-// @Param type query string false "Menu type filter"
+// @Param category query string false "Menu category id or name filter"
+// @Param type query string false "Alias of category"
 // @Param name query string false "Menu name filter"
+// @Param group query string false "Set to category to group the menus by category"
 // @Success 200 {object} model.PagedMenuResponse "Successfully retrieved menus"
+// @Failure 400 {object} model.Status "Unknown or inactive menu category"
 // @Failure 404 {object} model.Status "No menus found"
 // @Failure 500 {object} model.Status "Internal server error"
 // @Router /menu [get]
@@ -40,31 +49,78 @@ func (c *PublicController) GetMenuHandler(ctx *gin.Context){
 	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
 	size, _ := strconv.Atoi(ctx.DefaultQuery("size", "10"))
 
-	// Retrieve optional name and type filter from query
-	mtype := ctx.Query("type")
+	// Retrieve optional name and category filter from query, type is the older name of the category filter
+	category := ctx.Query("category")
+	if category == ""{
+		category = ctx.Query("type")
+	}
 	mname := ctx.Query("name")
 
 	// Call the usecase to fetch menus and pagination info
-	resp, paging, err := c.menuUc.GetAllMenu(page, size, mtype, mname)
+	resp, paging, err := c.menuUc.GetAllMenu(page, size, category, mname)
 	if err != nil{
-		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		status := http.StatusInternalServerError
+		if errors.Is(err, config.ErrUnknownMenuCategory){
+			status = http.StatusBadRequest
+		}
+		shared.SendErrorResponse(ctx, status, err.Error())
 		return
 	}
 
-	// Convert menu response data to a slice of empty interfaces for generic handling
+	// Convert menu response data to a slice of empty interfaces for generic handling,
+	// grouped by category when requested
 	var interfaceSlice = make([]interface{}, len(resp))
 	for i, v := range resp{
 		interfaceSlice[i] = v
 	}
+	if ctx.Query("group") == "category"{
+		groups := entity.GroupMenusByCategory(resp)
+		interfaceSlice = make([]interface{}, len(groups))
+		for i, v := range groups{
+			interfaceSlice[i] = v
+		}
+	}
 
 	// Check if the review data is empty, and if so, send a 404 Not Found response
 	if len(interfaceSlice) == 0{
 		shared.SendErrorResponse(ctx, http.StatusNotFound, "no menus found")
 		return
 	}
+
+	// Show customers whether they can order from the menu right now
+	status, err := c.restaurantUc.GetStoreStatus()
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
 	
-	// Send paged response with review data and pagination details
-	shared.SendPagedResponse(ctx, interfaceSlice, paging, "successfully retrieved menus")
+	// Send paged response with menu data, pagination details and the store status
+	shared.SendPagedStoreResponse(ctx, interfaceSlice, paging, status, "successfully retrieved menus")
+}
+
+// @Summary Get Active Menu Categories
+// @Description Retrieves the active menu categories in display order, for browsing and filtering the menu.
+// @Tags Public
+// @Success 200 {object} model.ListMenuCategoryResponse "Successfully retrieved menu categories"
+// @Failure 404 {object} model.Status "No menu categories found"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Router /menu/categories [get]
+func (c *PublicController) GetMenuCategoryHandler(ctx *gin.Context){
+	// Call the usecase to retrieve the active categories
+	resp, err := c.categoryUc.GetActiveMenuCategories()
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Return not found when there are no active categories
+	if len(resp) == 0{
+		shared.SendErrorResponse(ctx, http.StatusNotFound, "menu category data is empty")
+		return
+	}
+
+	// Send successfully response with the categories
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved menu categories")
 }
 
 // @Summary Get Reviews
@@ -104,7 +160,24 @@ func (c *PublicController) GetReviewHandler(ctx *gin.Context){
 	shared.SendPagedResponse(ctx, interfaceSlice, paging, "successfully retrieved reviews")
 }
 
+// @Summary Get Store Status
+// @Description Shows whether the restaurant is open for orders right now, when it closes, or when it opens next. Times are in the restaurant's timezone.
+// @Tags Public
+// @Success 200 {object} model.SingleStoreStatusResponse "Successfully retrieved store status"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Router /store-status [get]
+func (c *PublicController) GetStoreStatusHandler(ctx *gin.Context){
+	// Call the usecase to work out the current store status
+	resp, err := c.restaurantUc.GetStoreStatus()
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the store status
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved store status")
+}
 
-func NewPublicController(menuUc usecase.MenuUseCase, reviewUc usecase.ReviewUseCase, rg *gin.RouterGroup) *PublicController{
-	return &PublicController{menuUc: menuUc, reviewUc: reviewUc, rg: rg}
+func NewPublicController(menuUc usecase.MenuUseCase, categoryUc usecase.CategoryUseCase, reviewUc usecase.ReviewUseCase, restaurantUc usecase.RestaurantUseCase, rg *gin.RouterGroup) *PublicController{
+	return &PublicController{menuUc: menuUc, categoryUc: categoryUc, reviewUc: reviewUc, restaurantUc: restaurantUc, rg: rg}
 }
\ No newline at end of file
@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"food-delivery-apps/config"
+	"food-delivery-apps/shared"
+	"food-delivery-apps/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReportController struct{
+	orderUc usecase.OrderUseCase
+	rg *gin.RouterGroup
+}
+
+func (c *ReportController) Route(){
+	c.rg.GET(config.TipReport, c.GetTipReportHandler)
+}
+
+// @Summary Get Tip Report.
+// @Description Retrieves the total tips per day, week or month between two dates. Tips of cancelled orders are left out. Defaults to daily totals of the current month.
+// @Tags report
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param start_date query string false "Start date in YYYY-MM-DD format"
+// @Param end_date query string false "End date in YYYY-MM-DD format"
+// @Param group_by query string false "Period to group by: day, week or month" default(day)
+// @Success 200 {object} model.SingleTipReportResponse "Successfully retrieved tip report"
+// @Failure 500 {object} model.Status "Internal server error"
+// @Failure 401 {object} model.Status "Unauthorized"
+// @Failure 403 {object} model.Status "Forbidden access"
+// @Security BearerAuth
+// @Router /report/tips [get]
+func (c *ReportController) GetTipReportHandler(ctx *gin.Context){
+	// Retrieve optional period filters from query
+	startDate := ctx.Query("start_date")
+	endDate := ctx.Query("end_date")
+	groupBy := ctx.Query("group_by")
+
+	// Call the usecase to sum the tips of the period
+	resp, err := c.orderUc.GetTipReport(startDate, endDate, groupBy)
+	if err != nil{
+		shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Send successfully response with the tip report
+	shared.SendSingleResponse(ctx, resp, "successfully retrieved tip report")
+}
+
+func NewReportController(orderUc usecase.OrderUseCase, rg *gin.RouterGroup) *ReportController{
+	return &ReportController{orderUc: orderUc, rg: rg}
+}
@@ -11,6 +11,31 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// StreamTokenMiddleware moves the token EventSource clients pass as a query parameter into the Authorization header.
+// EventSource clients can't set headers, and the token must not end up in the access log,
+// so this middleware has to run before the logger.
+func StreamTokenMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		query := ctx.Request.URL.Query()
+		token := query.Get("token")
+		if token == ""{
+			ctx.Next()
+			return
+		}
+
+		// Only event streams may pass the token this way
+		if ctx.GetHeader("Authorization") == "" && strings.Contains(ctx.GetHeader("Accept"), "text/event-stream"){
+			ctx.Request.Header.Set("Authorization", "Bearer " + token)
+		}
+
+		// Drop the token from the query in every case, so it is never logged
+		query.Del("token")
+		ctx.Request.URL.RawQuery = query.Encode()
+
+		ctx.Next()
+	}
+}
+
 func JWTAuthMiddlewareWithRole(jwtService service.JwtService, userUc usecase.UserUseCase, allowedRoles []string) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		// Ensure the JwtService is not nil
@@ -0,0 +1,14 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// CacheControlMiddleware sets the Cache-Control header of the responses, such as files served from storage.
+// The header must be set before the handler writes the response.
+func CacheControlMiddleware(value string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Header("Cache-Control", value)
+		ctx.Next()
+	}
+}
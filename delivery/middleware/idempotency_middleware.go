@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"food-delivery-apps/config"
+	"food-delivery-apps/shared"
+	"food-delivery-apps/usecase"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// responseRecorder keeps a copy of the response body so it can be stored for replay
+type responseRecorder struct{
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *responseRecorder) Write(b []byte) (int, error){
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error){
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// IdempotencyMiddleware replays the stored response when a request is retried with the same Idempotency-Key.
+// It must run after JWTAuthMiddlewareWithRole, since keys are scoped per user.
+func IdempotencyMiddleware(idempotencyUc usecase.IdempotencyUseCase) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		// Requests without the header are processed as usual
+		key := ctx.GetHeader("Idempotency-Key")
+		if key == ""{
+			ctx.Next()
+			return
+		}
+		if len(key) > 255{
+			shared.SendErrorResponse(ctx, http.StatusBadRequest, "Idempotency-Key must be at most 255 characters")
+			ctx.Abort()
+			return
+		}
+
+		// Read the body for the fingerprint and put it back for the handler
+		body, err := io.ReadAll(ctx.Request.Body)
+		if err != nil{
+			shared.SendErrorResponse(ctx, http.StatusBadRequest, "failed to read request body")
+			ctx.Abort()
+			return
+		}
+		ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		// Fingerprint the request, so a key can't be reused for a different request
+		hash := sha256.New()
+		hash.Write([]byte(ctx.Request.Method + " " + ctx.Request.URL.Path + "\n"))
+		hash.Write(body)
+		requestHash := hex.EncodeToString(hash.Sum(nil))
+
+		// Claim the key, or get the stored response when it was used before
+		userId := ctx.MustGet("userID").(string)
+		record, replay, err := idempotencyUc.BeginRequest(userId, key, requestHash)
+		if err != nil{
+			if errors.Is(err, config.ErrIdempotencyKeyReused) || errors.Is(err, config.ErrIdempotencyKeyInProgress){
+				shared.SendErrorResponse(ctx, http.StatusConflict, err.Error())
+			} else {
+				shared.SendErrorResponse(ctx, http.StatusInternalServerError, err.Error())
+			}
+			ctx.Abort()
+			return
+		}
+
+		// Send back the original response without running the handler again
+		if replay{
+			ctx.Header("Idempotent-Replayed", "true")
+			ctx.Data(record.StatusCode, "application/json; charset=utf-8", record.ResponseBody)
+			ctx.Abort()
+			return
+		}
+
+		// Run the handler while keeping a copy of its response
+		recorder := &responseRecorder{ResponseWriter: ctx.Writer, body: &bytes.Buffer{}}
+		ctx.Writer = recorder
+
+		// A panicking handler stored nothing, so release the key before the panic reaches the recovery middleware
+		defer func(){
+			if p := recover(); p != nil{
+				if err := idempotencyUc.ReleaseRequest(record.Id); err != nil{
+					log.Printf("Error releasing idempotency key: %v\n", err.Error())
+				}
+				panic(p)
+			}
+		}()
+		ctx.Next()
+
+		// Server errors are not stored, so the client can retry with the same key
+		if recorder.Status() >= http.StatusInternalServerError{
+			if err := idempotencyUc.ReleaseRequest(record.Id); err != nil{
+				log.Printf("Error releasing idempotency key: %v\n", err.Error())
+			}
+			return
+		}
+		if err := idempotencyUc.CompleteRequest(record.Id, recorder.Status(), recorder.body.Bytes()); err != nil{
+			log.Printf("Error saving idempotent response: %v\n", err.Error())
+		}
+	}
+}
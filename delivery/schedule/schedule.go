@@ -3,12 +3,15 @@ package schedule
 import (
 	"food-delivery-apps/usecase"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/robfig/cron/v3"
 )
 
-func StartCronJob(userUc usecase.UserUseCase) {
-	c := cron.New(cron.WithSeconds())
+func StartCronJob(userUc usecase.UserUseCase, orderUc usecase.OrderUseCase, menuUc usecase.MenuUseCase, inventoryUc usecase.InventoryUseCase, idempotencyUc usecase.IdempotencyUseCase, location *time.Location) {
+	// Run the daily jobs at midnight in the restaurant's timezone
+	c := cron.New(cron.WithSeconds(), cron.WithLocation(location))
 
 	_, err := c.AddFunc("@every 10m", func() {
 			deletedRows, err := userUc.CleanUpExpiredTokens()
@@ -26,9 +29,75 @@ func StartCronJob(userUc usecase.UserUseCase) {
 			return
 	}
 
+	_, err = c.AddFunc("@every 1m", func() {
+			releasedOrders, err := orderUc.ReleaseScheduledOrders()
+			if err != nil {
+					log.Printf("Error releasing scheduled orders: %v\n", err.Error())
+			}
+			if releasedOrders > 0 {
+					log.Printf("Scheduled orders released to the kitchen: %d orders\n", releasedOrders)
+			}
+	})
+
+	if err != nil {
+			log.Printf("Error scheduling cron job: %v\n", err.Error())
+			return
+	}
+
+	_, err = c.AddFunc("@every 1h", func() {
+			deletedRows, err := idempotencyUc.CleanUpExpiredKeys()
+			if err != nil {
+					log.Printf("Error cleaning up idempotency keys: %v\n", err.Error())
+			} else if deletedRows > 0 {
+					log.Printf("Expired idempotency keys cleaned up: %d keys deleted\n", deletedRows)
+			}
+	})
+
+	if err != nil {
+			log.Printf("Error scheduling cron job: %v\n", err.Error())
+			return
+	}
+
+	_, err = c.AddFunc("0 0 0 * * *", func() {
+			resetMenus, err := menuUc.ResetDailyStock()
+			if err != nil {
+					log.Printf("Error resetting daily stock: %v\n", err.Error())
+			} else {
+					log.Printf("Daily stock reset: %d menus restocked\n", resetMenus)
+			}
+	})
+
+	if err != nil {
+			log.Printf("Error scheduling cron job: %v\n", err.Error())
+			return
+	}
+
+	_, err = c.AddFunc("0 0 * * * *", func() {
+			ingredients, err := inventoryUc.GetLowStockIngredients()
+			if err != nil {
+					log.Printf("Error checking ingredient stock: %v\n", err.Error())
+			}
+			for _, ingredient := range ingredients {
+					log.Printf("Low stock: %s has %.2f %s left (reorder at %.2f), used by %s\n", ingredient.Name,
+						ingredient.QuantityOnHand, ingredient.Unit, ingredient.ReorderThreshold, affectedMenus(ingredient.AffectedMenus))
+			}
+	})
+
+	if err != nil {
+			log.Printf("Error scheduling cron job: %v\n", err.Error())
+			return
+	}
+
 	c.Start()
 	defer c.Stop()
 
 	select{}
 }
 
+func affectedMenus(menus []string) string {
+	if len(menus) == 0 {
+		return "no menu items"
+	}
+
+	return strings.Join(menus, ", ")
+}
@@ -30,7 +30,15 @@ type Server struct {
 	balanceUc usecase.BalanceUseCase
 	reviewUc usecase.ReviewUseCase
 	promoUc usecase.PromoUseCase
+	cartUc usecase.CartUseCase
+	addressUc usecase.AddressUseCase
+	deliveryUc usecase.DeliveryUseCase
+	restaurantUc usecase.RestaurantUseCase
+	inventoryUc usecase.InventoryUseCase
+	categoryUc usecase.CategoryUseCase
+	idempotencyUc usecase.IdempotencyUseCase
 	jwtService service.JwtService
+	storageCfg config.StorageConfig
 }
 
 func (s *Server) initRoute(){	
@@ -39,27 +47,44 @@ func (s *Server) initRoute(){
 
 	// Public Routes
 	controller.NewAuthController(s.authUc, rg).Route()
-	controller.NewPublicController(s.menuUc, s.reviewUc, rg).Route()
+	controller.NewPublicController(s.menuUc, s.categoryUc, s.reviewUc, s.restaurantUc, rg).Route()
 
 	// Admin Routes
 	adminRg := s.engine.Group(config.ApiGroup)
 	adminRg.Use(middleware.JWTAuthMiddlewareWithRole(s.jwtService, s.userUc, []string{"admin"}))
-	controller.NewAdminController(s.userUc, adminRg).Route()
+	controller.NewAdminController(s.userUc, s.deliveryUc, s.restaurantUc, adminRg).Route()
 
 	// Authentication Routes
 	userRg := s.engine.Group(config.ApiGroup)
-	userRg.Use(middleware.JWTAuthMiddlewareWithRole(s.jwtService, s.userUc, []string{"admin", "customer", "employee"}))
+	userRg.Use(middleware.JWTAuthMiddlewareWithRole(s.jwtService, s.userUc, []string{"admin", "customer", "employee", "courier"}))
 	controller.NewUserController(s.userUc, userRg).Route()
 
 	// Employee Routes
 	employeeRg := s.engine.Group(config.ApiGroup)
 	employeeRg.Use(middleware.JWTAuthMiddlewareWithRole(s.jwtService, s.userUc, []string{"employee"}))
 	controller.NewEmployeeController(s.menuUc, s.orderUc, s.promoUc, employeeRg).Route()
+	controller.NewInventoryController(s.inventoryUc, employeeRg).Route()
+
+	// Report and Category Routes
+	reportRg := s.engine.Group(config.ApiGroup)
+	reportRg.Use(middleware.JWTAuthMiddlewareWithRole(s.jwtService, s.userUc, []string{"admin", "employee"}))
+	controller.NewReportController(s.orderUc, reportRg).Route()
+	controller.NewCategoryController(s.categoryUc, reportRg).Route()
 
 	// Customer Routes
 	customerRg := s.engine.Group(config.ApiGroup)
 	customerRg.Use(middleware.JWTAuthMiddlewareWithRole(s.jwtService, s.userUc, []string{"customer"}))
-	controller.NewCustomerController(s.orderUc, s.balanceUc, s.reviewUc, s.promoUc, customerRg).Route()
+	controller.NewCustomerController(s.orderUc, s.balanceUc, s.reviewUc, s.promoUc, s.cartUc, s.addressUc, s.deliveryUc, s.idempotencyUc, customerRg).Route()
+
+	// Courier Routes
+	courierRg := s.engine.Group(config.ApiGroup)
+	courierRg.Use(middleware.JWTAuthMiddlewareWithRole(s.jwtService, s.userUc, []string{"courier"}))
+	controller.NewCourierController(s.orderUc, courierRg).Route()
+
+	// Uploaded files such as menu images, stored files never change so browsers may keep them
+	uploadsRg := s.engine.Group(config.UploadsPath)
+	uploadsRg.Use(middleware.CacheControlMiddleware(config.UploadsCacheControl))
+	uploadsRg.Static("/", s.storageCfg.Dir)
 
 	s.engine.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 }
@@ -76,7 +101,10 @@ func (s *Server) Run(){
 
 func NewServer() *Server{
 	// Load configuration from the config files
-	cfg, _ := config.NewConfig()
+	cfg, err := config.NewConfig()
+	if err != nil{
+		panic(fmt.Errorf("failed to load config: %v", err.Error()))
+	}
 
 	// Build the database connection string using the configuration
 	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
@@ -94,26 +122,53 @@ func NewServer() *Server{
 	userUc := usecase.NewUserUseCase(userRepo)
 	authUc := usecase.NewAuthUseCase(userUc, jwtService)
 
+	txManager := repository.NewTransactionManager(db)
+
 	menuRepo := repository.NewMenuRepository(db)
-	menuUc := usecase.NewMenuUseCase(menuRepo)
+	modifierRepo := repository.NewModifierRepository(db)
+	categoryRepo := repository.NewCategoryRepository(db)
+	storage := service.NewLocalStorage(cfg.StorageConfig)
+	menuUc := usecase.NewMenuUseCase(menuRepo, modifierRepo, categoryRepo, txManager, storage, cfg.MenuConfig, cfg.StorageConfig)
+	categoryUc := usecase.NewCategoryUseCase(categoryRepo)
 
 	balanceRepo := repository.NewBalanceRepository(db)
-	balanceUc := usecase.NewBalanceUseCase(balanceRepo)
+	balanceUc := usecase.NewBalanceUseCase(balanceRepo, txManager)
 
 	promoRepo := repository.NewPromoRepository(db)
 	promoUc := usecase.NewPromoUseCase(promoRepo)
 
+	addressRepo := repository.NewAddressRepository(db)
+	addressUc := usecase.NewAddressUseCase(addressRepo)
+
+	restaurantRepo := repository.NewRestaurantRepository(db)
+	restaurantUc := usecase.NewRestaurantUseCase(restaurantRepo, cfg.RestaurantConfig)
+
+	deliveryZoneRepo := repository.NewDeliveryZoneRepository(db)
+	deliveryUc := usecase.NewDeliveryUseCase(deliveryZoneRepo, addressRepo, txManager, cfg.RestaurantConfig)
+
 	orderRepo := repository.NewOrderRepository(db)
-	orderUc := usecase.NewOrderUseCase(orderRepo, menuRepo, balanceRepo, promoRepo)
+	eventHub := service.NewEventHub(config.EventHistorySize)
+	pricingUc := usecase.NewPricingUseCase(menuRepo, promoRepo, modifierRepo, balanceRepo, deliveryUc, cfg.PricingConfig)
+	orderUc := usecase.NewOrderUseCase(orderRepo, restaurantUc, pricingUc, txManager, eventHub, cfg.OrderConfig, cfg.RestaurantConfig)
+
+	cartRepo := repository.NewCartRepository(db)
+	cartUc := usecase.NewCartUseCase(cartRepo, menuRepo, modifierRepo, orderUc)
 
 	reviewRepo := repository.NewReviewRepository(db)
 	reviewUc := usecase.NewReviewUseCase(reviewRepo, orderRepo)
 
-	// Set up the Gin engine for routing and middleware
-	engine := gin.Default()
+	inventoryRepo := repository.NewInventoryRepository(db)
+	inventoryUc := usecase.NewInventoryUseCase(inventoryRepo, menuRepo, txManager)
+
+	idempotencyRepo := repository.NewIdempotencyRepository(db)
+	idempotencyUc := usecase.NewIdempotencyUseCase(idempotencyRepo)
+
+	// Set up the Gin engine for routing and middleware, stream tokens are moved out of the query before it is logged
+	engine := gin.New()
+	engine.Use(middleware.StreamTokenMiddleware(), gin.Logger(), gin.Recovery())
 	
 	// Start a background job for periodic tasks
-	go schedule.StartCronJob(userUc)
+	go schedule.StartCronJob(userUc, orderUc, menuUc, inventoryUc, idempotencyUc, cfg.RestaurantConfig.Location)
 	
 	// Define the host and return the server instance with all initialized components
 	host := fmt.Sprintf(":%s", cfg.Apiport)
@@ -127,6 +182,14 @@ func NewServer() *Server{
 		balanceUc: balanceUc,
 		reviewUc: reviewUc,
 		promoUc: promoUc,
+		cartUc: cartUc,
+		addressUc: addressUc,
+		deliveryUc: deliveryUc,
+		restaurantUc: restaurantUc,
+		inventoryUc: inventoryUc,
+		categoryUc: categoryUc,
+		idempotencyUc: idempotencyUc,
 		jwtService: jwtService,
+		storageCfg: cfg.StorageConfig,
 	}
 }
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"fmt"
+	"food-delivery-apps/config"
+	"time"
+)
+
+type CartItem struct{
+	Id string `json:"id"`
+	CustomerId string `json:"-"`
+	MenuId string `json:"menu_id"`
+	MenuName string `json:"-"`
+	Quantity int `json:"quantity"`
+	OptionIds []string `json:"option_ids"`
+	Price float64 `json:"-"`
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+type CartItemResponse struct{
+	Id string `json:"id"`
+	MenuId string `json:"menu_id"`
+	MenuName string `json:"menu_name"`
+	Quantity int `json:"quantity"`
+	Options []OrderItemOption `json:"options"`
+	AddedPrice float64 `json:"added_price"`
+	UnitPrice float64 `json:"unit_price"`
+	LineTotal float64 `json:"line_total"`
+	IsDeleted bool `json:"is_deleted"`
+	IsRepriced bool `json:"is_repriced"`
+	IsSoldOut bool `json:"is_sold_out"`
+	InvalidOptions string `json:"invalid_options,omitempty"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type CartResponse struct{
+	Items []CartItemResponse `json:"items"`
+	TotalPrice float64 `json:"total_price"`
+	HasChanges bool `json:"has_changes"`
+}
+
+type CartCheckout struct{
+	CustomerId string `json:"-"`
+	AddressId string `json:"address_id"`
+	PromoCode string `json:"promo_code"`
+	Note string `json:"note"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+	TipAmount float64 `json:"tip_amount"`
+	AcceptPriceChanges bool `json:"accept_price_changes"`
+}
+
+func (c *CartItem) Validate() error{
+	if c.MenuId == ""{
+		return config.ErrMissingFields
+	}
+
+	if c.Quantity <= 0{
+		return fmt.Errorf("can't set quantity to zero or below")
+	}
+
+	return nil
+}
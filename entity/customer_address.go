@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"food-delivery-apps/config"
+	"time"
+)
+
+type CustomerAddress struct{
+	Id string `json:"id"`
+	CustomerId string `json:"-"`
+	Label string `json:"label"`
+	Address string `json:"address"`
+	Latitude float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type CustomerAddressResponse struct{
+	Id string `json:"id"`
+	Label string `json:"label,omitempty"`
+	Address string `json:"address"`
+	Latitude float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+func (a *CustomerAddress) Validate() error{
+	// Coordinates are picked on the client, so 0, 0 means they weren't sent
+	if a.Address == "" || (a.Latitude == 0 && a.Longitude == 0){
+		return config.ErrMissingFields
+	}
+
+	if a.Latitude < -90 || a.Latitude > 90 || a.Longitude < -180 || a.Longitude > 180{
+		return config.ErrInvalidCoordinates
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"fmt"
+	"food-delivery-apps/config"
+	"math"
+	"sort"
+	"time"
+)
+
+type DeliveryZone struct{
+	Id string `json:"id"`
+	Name string `json:"name"`
+	ZoneType string `json:"zone_type"`
+	RadiusKm float64 `json:"radius_km,omitempty"`
+	Polygon [][]float64 `json:"polygon,omitempty"`
+	Fees []DeliveryZoneFee `json:"fees"`
+	CreatedAt time.Time `json:"-"`
+}
+
+type DeliveryZoneFee struct{
+	Id string `json:"id"`
+	ZoneId string `json:"-"`
+	MaxDistanceKm float64 `json:"max_distance_km"`
+	Fee float64 `json:"fee"`
+}
+
+type DeliveryQuote struct{
+	AddressId string `json:"address_id"`
+	Address string `json:"address"`
+	ZoneName string `json:"zone_name"`
+	DistanceKm float64 `json:"distance_km"`
+	DeliveryFee float64 `json:"delivery_fee"`
+}
+
+func (z *DeliveryZone) Validate() error{
+	if z.Name == "" || z.ZoneType == "" || len(z.Fees) == 0{
+		return config.ErrMissingFields
+	}
+
+	switch z.ZoneType{
+	case "radius":
+		if z.RadiusKm <= 0{
+			return fmt.Errorf("radius_km must be greater than zero")
+		}
+		z.Polygon = nil
+	case "polygon":
+		if len(z.Polygon) < 3{
+			return fmt.Errorf("polygon needs at least three points")
+		}
+		for _, point := range z.Polygon{
+			if len(point) != 2 || point[0] < -90 || point[0] > 90 || point[1] < -180 || point[1] > 180{
+				return fmt.Errorf("polygon points must be [latitude, longitude] pairs")
+			}
+		}
+		z.RadiusKm = 0
+	default:
+		return config.ErrInvalidZoneType
+	}
+
+	for _, fee := range z.Fees{
+		if fee.MaxDistanceKm <= 0 || fee.Fee < 0{
+			return fmt.Errorf("fee tiers need a max_distance_km above zero and a fee of zero or more")
+		}
+	}
+
+	// Keep the fee tiers ordered by distance, so the first matching tier is the closest one
+	sort.Slice(z.Fees, func(i, j int) bool {
+		return z.Fees[i].MaxDistanceKm < z.Fees[j].MaxDistanceKm
+	})
+
+	return nil
+}
+
+// Contains reports whether a point at distanceKm from the restaurant lies inside the zone.
+func (z *DeliveryZone) Contains(latitude, longitude, distanceKm float64) bool{
+	if z.ZoneType == "radius"{
+		return distanceKm <= z.RadiusKm
+	}
+
+	// Ray casting: the point is inside when a ray from it crosses the polygon edges an odd number of times
+	inside := false
+	for i, j := 0, len(z.Polygon)-1; i < len(z.Polygon); j, i = i, i+1{
+		latI, lngI := z.Polygon[i][0], z.Polygon[i][1]
+		latJ, lngJ := z.Polygon[j][0], z.Polygon[j][1]
+		if (lngI > longitude) != (lngJ > longitude) &&
+			latitude < (latJ-latI)*(longitude-lngI)/(lngJ-lngI)+latI{
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+// FeeFor returns the fee of the first tier covering distanceKm, or the last tier when the zone reaches further.
+func (z *DeliveryZone) FeeFor(distanceKm float64) float64{
+	if len(z.Fees) == 0{
+		return 0
+	}
+
+	for _, fee := range z.Fees{
+		if distanceKm <= fee.MaxDistanceKm{
+			return fee.Fee
+		}
+	}
+
+	return z.Fees[len(z.Fees)-1].Fee
+}
+
+// DistanceKm returns the great-circle distance between two coordinates using the haversine formula.
+func DistanceKm(fromLatitude, fromLongitude, toLatitude, toLongitude float64) float64{
+	const earthRadiusKm = 6371.0
+
+	dLat := (toLatitude - fromLatitude) * math.Pi / 180
+	dLng := (toLongitude - fromLongitude) * math.Pi / 180
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(fromLatitude*math.Pi/180)*math.Cos(toLatitude*math.Pi/180)*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return earthRadiusKm * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+type IdempotencyKey struct{
+	Id string `json:"id"`
+	UserId string `json:"user_id"`
+	Key string `json:"idempotency_key"`
+	RequestHash string `json:"request_hash"`
+	StatusCode int `json:"status_code"`
+	ResponseBody []byte `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+}
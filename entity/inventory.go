@@ -0,0 +1,106 @@
+package entity
+
+import (
+	"fmt"
+	"food-delivery-apps/config"
+	"time"
+)
+
+type Ingredient struct{
+	Id string `json:"id"`
+	Name string `json:"name"`
+	Unit string `json:"unit"`
+	QuantityOnHand float64 `json:"quantity_on_hand"`
+	ReorderThreshold float64 `json:"reorder_threshold"`
+	IsLow bool `json:"is_low"`
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+type LowStockIngredient struct{
+	Ingredient
+	AffectedMenus []string `json:"affected_menus"`
+}
+
+type RecipeItem struct{
+	IngredientId string `json:"ingredient_id"`
+	IngredientName string `json:"ingredient_name"`
+	Unit string `json:"unit"`
+	Quantity float64 `json:"quantity"`
+}
+
+type Recipe struct{
+	MenuId string `json:"menu_id"`
+	Items []RecipeItem `json:"items"`
+}
+
+type StockMovement struct{
+	Id string `json:"id"`
+	IngredientId string `json:"-"`
+	MovementType string `json:"movement_type"`
+	Quantity float64 `json:"quantity"`
+	OrderId string `json:"order_id,omitempty"`
+	Note string `json:"note,omitempty"`
+	CreatedBy string `json:"-"`
+	CreatedAt string `json:"created_at"`
+}
+
+func (i *Ingredient) Validate() error{
+	if i.Name == "" || i.Unit == ""{
+		return config.ErrMissingFields
+	}
+
+	if i.QuantityOnHand < 0{
+		return fmt.Errorf("quantity_on_hand cannot be below zero")
+	}
+	if i.ReorderThreshold < 0{
+		return fmt.Errorf("reorder_threshold cannot be below zero")
+	}
+
+	return nil
+}
+
+// SetLowFlag marks the ingredient as low once the quantity on hand is at or below its reorder threshold.
+func (i *Ingredient) SetLowFlag(){
+	i.IsLow = i.QuantityOnHand <= i.ReorderThreshold
+}
+
+func (r *Recipe) Validate() error{
+	// An empty recipe clears the bill of materials of the menu item
+	seen := make(map[string]bool)
+	for _, item := range r.Items{
+		if item.IngredientId == ""{
+			return config.ErrMissingFields
+		}
+		if item.Quantity <= 0{
+			return fmt.Errorf("recipe quantities must be greater than zero")
+		}
+		if seen[item.IngredientId]{
+			return fmt.Errorf("ingredient %s is listed more than once", item.IngredientId)
+		}
+		seen[item.IngredientId] = true
+	}
+
+	return nil
+}
+
+func (m *StockMovement) Validate() error{
+	switch m.MovementType{
+	case "receipt":
+		if m.Quantity <= 0{
+			return fmt.Errorf("received quantity must be greater than zero")
+		}
+	case "adjustment":
+		// Adjustments correct the count after waste or a stocktake, so the reason is required
+		if m.Quantity == 0{
+			return fmt.Errorf("adjustment quantity can't be zero")
+		}
+		if m.Note == ""{
+			return fmt.Errorf("note is required to explain the adjustment")
+		}
+	default:
+		return fmt.Errorf("movement type must be receipt or adjustment")
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+type KitchenQueueOrder struct{
+	Position int `json:"position"`
+	Id string `json:"id"`
+	CustomerName string `json:"customer_name"`
+	OrderStatus string `json:"order_status"`
+	Note string `json:"note,omitempty"`
+	ScheduledAt string `json:"scheduled_at,omitempty"`
+	CreatedAt string `json:"created_at"`
+	PreparationTime int `json:"preparation_time"`
+	ExpectedReadyAt string `json:"expected_ready_at"`
+	OrderItems []OrderItem `json:"order_items"`
+	ReadyAt time.Time `json:"-"`
+}
+
+// RemainingPreparationTime returns the minutes left to prepare the order.
+// Items of one order are cooked side by side, so the slowest unfinished item sets the time.
+func (o *KitchenQueueOrder) RemainingPreparationTime() int{
+	remaining := 0
+	for _, item := range o.OrderItems{
+		if !item.IsDone && item.PreparationTime > remaining{
+			remaining = item.PreparationTime
+		}
+	}
+
+	return remaining
+}
@@ -9,10 +9,13 @@ import (
 type Menu struct{
 	Id string `json:"id"`
 	Name string `json:"name"`
-	Type string `json:"type"`
+	CategoryId string `json:"category_id"`
 	Desc string `json:"description"`
-	UnitType string `json:"unit_type"`
+	UnitTypeId string `json:"unit_type_id"`
 	Price float64 `json:"price"`
+	PreparationTime int `json:"preparation_time"`
+	IsAvailable bool `json:"-"`
+	StockLeft *int `json:"-"`
 	Rating float64 `json:"rating"`
 	CreatedBy string `json:"-"`
 	CreatedAt time.Time `json:"createdAt"`
@@ -22,27 +25,53 @@ type Menu struct{
 type MenuResponse struct{
 	Id string `json:"id"`
 	Name string `json:"name"`
-	Type string `json:"type"`
+	CategoryId string `json:"category_id"`
+	Category string `json:"category"`
 	Desc string `json:"description"`
+	UnitTypeId string `json:"unit_type_id"`
 	UnitType string `json:"unit_type"`
 	Price float64 `json:"price"`
+	PreparationTime int `json:"preparation_time"`
+	IsAvailable bool `json:"is_available"`
+	DailyStock *int `json:"daily_stock,omitempty"`
+	StockLeft *int `json:"stock_left,omitempty"`
+	SoldOut bool `json:"sold_out"`
+	LowStock bool `json:"low_stock"`
 	Rating float64 `json:"rating"`
 	CreatedBy string `json:"-"`
 	CreatedAt string `json:"createdAt"`
 	UpdatedAt string `json:"updatedAt"`
+	ArchivedAt string `json:"archivedAt,omitempty"`
+	ImageKey string `json:"-"`
+	Image *MenuImage `json:"image,omitempty"`
+	ModifierGroups []ModifierGroup `json:"modifier_groups,omitempty"`
 }
 
-func (m *Menu) Validate() error{
-	if m.Name == "" || m.Type == "" || m.Desc == "" || m.UnitType == "" || m.Price == 0{
-		return config.ErrMissingFields
-	}
+type MenuImage struct{
+	Url string `json:"url"`
+	Thumbnails map[string]string `json:"thumbnails"`
+}
 
-	if m.Type != "main dish" && m.Type != "side dish" && m.Type != "dessert" && m.Type != "beverage"{
-		return config.ErrInvalidMenuType
-	}
+type MenuCategoryGroup struct{
+	CategoryId string `json:"category_id"`
+	Category string `json:"category"`
+	Menus []MenuResponse `json:"menus"`
+}
 
-	if m.UnitType != "piece" && m.UnitType != "portion" && m.UnitType != "packet" && m.UnitType != "cup"{
-		return config.ErrInvalidUnitType
+type MenuStock struct{
+	MenuId string `json:"-"`
+	DailyStock *int `json:"daily_stock"`
+	StockLeft *int `json:"stock_left"`
+}
+
+type MenuAvailability struct{
+	MenuId string `json:"-"`
+	IsAvailable *bool `json:"is_available"`
+}
+
+func (m *Menu) Validate() error{
+	if m.Name == "" || m.CategoryId == "" || m.Desc == "" || m.UnitTypeId == "" || m.Price == 0{
+		return config.ErrMissingFields
 	}
 
 	if m.Price != 0{
@@ -53,24 +82,15 @@ func (m *Menu) Validate() error{
 			return fmt.Errorf("minimum price is 1000")
 		}
 	}
+
+	if m.PreparationTime < 0{
+		return fmt.Errorf("preparation time cannot be below zero")
+	}
 	
 	return nil
 }
 
 func (m *Menu) ValidateUpdate() error{
-	
-	if m.Type != ""{
-		if m.Type != "main dish" && m.Type != "side dish" && m.Type != "dessert" && m.Type != "beverage"{
-			return config.ErrInvalidMenuType
-		}
-	}
-
-	if m.UnitType != ""{
-		if m.UnitType != "piece" && m.UnitType != "portion" && m.UnitType != "packet" && m.UnitType != "cup"{
-			return config.ErrInvalidUnitType
-		}
-	}
-	
 	if m.Price != 0{
 		if m.Price < 0{
 			return fmt.Errorf("price cannot be below zero")
@@ -79,6 +99,82 @@ func (m *Menu) ValidateUpdate() error{
 			return fmt.Errorf("minimum price is 500")
 		}
 	}
+
+	if m.PreparationTime < 0{
+		return fmt.Errorf("preparation time cannot be below zero")
+	}
 	
 	return nil
-}
\ No newline at end of file
+}
+
+// SetStockFlags marks a tracked menu item as sold out, or as low on stock once lowStockThreshold or fewer are left.
+func (m *MenuResponse) SetStockFlags(lowStockThreshold int){
+	if m.StockLeft == nil{
+		return
+	}
+
+	m.SoldOut = *m.StockLeft == 0
+	m.LowStock = *m.StockLeft > 0 && *m.StockLeft <= lowStockThreshold
+}
+
+// GroupMenusByCategory groups menus that are already ordered by category, keeping that order.
+func GroupMenusByCategory(menus []MenuResponse) []MenuCategoryGroup{
+	groups := []MenuCategoryGroup{}
+	for _, menu := range menus{
+		if len(groups) == 0 || groups[len(groups)-1].CategoryId != menu.CategoryId{
+			groups = append(groups, MenuCategoryGroup{CategoryId: menu.CategoryId, Category: menu.Category})
+		}
+		last := &groups[len(groups)-1]
+		last.Menus = append(last.Menus, menu)
+	}
+
+	return groups
+}
+
+func (s *MenuStock) Validate() error{
+	// Without a daily stock the menu item isn't tracked
+	if s.DailyStock == nil{
+		if s.StockLeft != nil{
+			return fmt.Errorf("daily_stock is required to set stock_left")
+		}
+		return nil
+	}
+
+	if *s.DailyStock < 0{
+		return fmt.Errorf("daily_stock cannot be below zero")
+	}
+
+	// Start from the full daily stock unless a count of what is left is given
+	if s.StockLeft == nil{
+		stockLeft := *s.DailyStock
+		s.StockLeft = &stockLeft
+	}
+	if *s.StockLeft < 0 || *s.StockLeft > *s.DailyStock{
+		return fmt.Errorf("stock_left must be between zero and daily_stock")
+	}
+
+	return nil
+}
+
+func (a *MenuAvailability) Validate() error{
+	if a.IsAvailable == nil{
+		return config.ErrMissingFields
+	}
+
+	return nil
+}
+
+// StockError explains why an order for a menu item can't be taken with the stock that is left.
+func StockError(menuName string, isAvailable bool, stockLeft *int) error{
+	if !isAvailable{
+		return fmt.Errorf("%w: %s", config.ErrMenuUnavailable, menuName)
+	}
+	if stockLeft != nil && *stockLeft == 0{
+		return fmt.Errorf("%w, %s is sold out", config.ErrNotEnoughStock, menuName)
+	}
+	if stockLeft != nil{
+		return fmt.Errorf("%w, only %d %s left", config.ErrNotEnoughStock, *stockLeft, menuName)
+	}
+
+	return fmt.Errorf("%w: %s", config.ErrMenuUnavailable, menuName)
+}
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"fmt"
+	"food-delivery-apps/config"
+	"time"
+)
+
+type MenuCategory struct{
+	Id string `json:"id"`
+	Name string `json:"name"`
+	Desc string `json:"description"`
+	DisplayOrder int `json:"display_order"`
+	IsActive *bool `json:"is_active"`
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+type UnitType struct{
+	Id string `json:"id"`
+	Name string `json:"name"`
+	Desc string `json:"description"`
+	DisplayOrder int `json:"display_order"`
+	IsActive *bool `json:"is_active"`
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+func (c *MenuCategory) Validate() error{
+	if c.Name == ""{
+		return config.ErrMissingFields
+	}
+
+	if c.DisplayOrder < 0{
+		return fmt.Errorf("display order cannot be below zero")
+	}
+
+	// New categories are active unless stated otherwise
+	if c.IsActive == nil{
+		isActive := true
+		c.IsActive = &isActive
+	}
+
+	return nil
+}
+
+func (t *UnitType) Validate() error{
+	if t.Name == ""{
+		return config.ErrMissingFields
+	}
+
+	if t.DisplayOrder < 0{
+		return fmt.Errorf("display order cannot be below zero")
+	}
+
+	// New unit types are active unless stated otherwise
+	if t.IsActive == nil{
+		isActive := true
+		t.IsActive = &isActive
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package entity
+
+import (
+	"fmt"
+	"food-delivery-apps/config"
+	"time"
+)
+
+type ModifierGroup struct{
+	Id string `json:"id"`
+	MenuId string `json:"-"`
+	Name string `json:"name"`
+	IsRequired bool `json:"is_required"`
+	MinSelect int `json:"min_select"`
+	MaxSelect int `json:"max_select"`
+	Options []ModifierOption `json:"options"`
+	CreatedAt time.Time `json:"-"`
+}
+
+type ModifierOption struct{
+	Id string `json:"id"`
+	GroupId string `json:"-"`
+	Name string `json:"name"`
+	PriceDelta float64 `json:"price_delta"`
+}
+
+type OrderItemOption struct{
+	OptionId string `json:"option_id"`
+	GroupName string `json:"group_name"`
+	OptionName string `json:"option_name"`
+	PriceDelta float64 `json:"price_delta"`
+}
+
+func (g *ModifierGroup) Validate() error{
+	if g.Name == "" || len(g.Options) == 0{
+		return config.ErrMissingFields
+	}
+
+	// A required group needs at least one pick
+	if g.IsRequired && g.MinSelect < 1{
+		g.MinSelect = 1
+	}
+
+	// Default to a single pick when no maximum is given
+	if g.MaxSelect == 0{
+		g.MaxSelect = 1
+	}
+
+	if g.MinSelect < 0 || g.MaxSelect < g.MinSelect{
+		return fmt.Errorf("min_select must be between zero and max_select")
+	}
+
+	if g.MaxSelect > len(g.Options){
+		return fmt.Errorf("max_select can't be more than the number of options")
+	}
+
+	for _, option := range g.Options{
+		if option.Name == ""{
+			return config.ErrMissingFields
+		}
+	}
+
+	return nil
+}
+
+// SelectModifierOptions checks the selected option ids against the menu's modifier groups,
+// and returns the chosen options with their price deltas.
+func SelectModifierOptions(groups []ModifierGroup, optionIds []string) ([]OrderItemOption, error){
+	selected := make(map[string]bool)
+	for _, id := range optionIds{
+		if selected[id]{
+			return nil, fmt.Errorf("option %s is selected more than once", id)
+		}
+		selected[id] = true
+	}
+
+	options := []OrderItemOption{}
+	for _, group := range groups{
+		// Collect the options picked from this group
+		picked := 0
+		for _, option := range group.Options{
+			if !selected[option.Id]{
+				continue
+			}
+
+			picked++
+			delete(selected, option.Id)
+			options = append(options, OrderItemOption{
+				OptionId: option.Id,
+				GroupName: group.Name,
+				OptionName: option.Name,
+				PriceDelta: option.PriceDelta,
+			})
+		}
+
+		// Ensure the number of picks fits the group's rules
+		if picked < group.MinSelect{
+			return nil, fmt.Errorf("choose at least %d option(s) for %s", group.MinSelect, group.Name)
+		}
+		if picked > group.MaxSelect{
+			return nil, fmt.Errorf("choose at most %d option(s) for %s", group.MaxSelect, group.Name)
+		}
+	}
+
+	// Any id left over doesn't belong to this menu
+	for id := range selected{
+		return nil, fmt.Errorf("option %s is not available for this menu", id)
+	}
+
+	return options, nil
+}
@@ -9,12 +9,24 @@ import (
 type Order struct{
 	Id string `json:"id"`
 	CustomerId string `json:"customer_id"`
+	CourierId string `json:"courier_id"`
+	AddressId string `json:"address_id"`
 	Address string `json:"address"`
 	PromoCode string `json:"promo_code"`
 	OrderStatus string `json:"order_status"`
 	Note string `json:"note"`
 	Date time.Time `json:"date"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+	Subtotal float64 `json:"subtotal"`
+	DiscountAmount float64 `json:"discount_amount"`
+	DeliveryDistance float64 `json:"delivery_distance"`
+	DeliveryFee float64 `json:"delivery_fee"`
+	ServiceCharge float64 `json:"service_charge"`
+	TaxAmount float64 `json:"tax_amount"`
+	TaxInclusive bool `json:"tax_inclusive"`
 	TotalPrice float64 `json:"total_price"`
+	TipAmount float64 `json:"tip_amount"`
+	CancelReason string `json:"cancel_reason"`
 	CreatedAt  time.Time `json:"created_at"`
 	OrderItems  []OrderItem `json:"order_items"`
 }
@@ -22,37 +34,168 @@ type Order struct{
 type OrderResponse struct{
 	Id string `json:"id"`
 	CustomerName string `json:"customer_name,omitempty"`
+	CourierName string `json:"courier_name,omitempty"`
 	PromoCode string `json:"promo_code,omitempty"`
 	Address string `json:"address"`
 	OrderStatus string `json:"order_status"`
 	Note string `json:"note,omitempty"`
 	Date string `json:"date,omitempty"`
+	ScheduledAt string `json:"scheduled_at,omitempty"`
+	Subtotal float64 `json:"subtotal"`
+	DiscountAmount float64 `json:"discount_amount"`
+	DeliveryFee float64 `json:"delivery_fee"`
+	ServiceCharge float64 `json:"service_charge"`
+	TaxAmount float64 `json:"tax_amount"`
+	TaxInclusive bool `json:"tax_inclusive"`
 	TotalPrice float64 `json:"total_price"`
+	TipAmount float64 `json:"tip_amount"`
+	CancelReason string `json:"cancel_reason,omitempty"`
+	EstimatedDeliveryAt string `json:"estimated_delivery_at,omitempty"`
 	CreatedAt  string `json:"created_at"`
 	OrderItems  []OrderItem `json:"order_items"`
+	DeliveryDistance float64 `json:"-"`
+	StatusAge time.Duration `json:"-"`
+	StatusHistory []OrderStatusHistoryResponse `json:"status_history,omitempty"`
 }
 
 type OrderItem struct{
 	Id string `json:"id"`
 	OrderId string `json:"-"`
+	MenuId string `json:"-"`
 	MenuName string `json:"menu_name"`
 	Quantity int `json:"quantity"`
+	OptionIds []string `json:"option_ids,omitempty"`
+	UnitPrice float64 `json:"unit_price"`
+	LineTotal float64 `json:"line_total"`
+	PreparationTime int `json:"preparation_time"`
+	IsDone bool `json:"is_done"`
+	Options []OrderItemOption `json:"options"`
 }
 
+type ReorderItem struct{
+	MenuName string `json:"menu_name"`
+	Quantity int `json:"quantity"`
+	PreviousUnitPrice float64 `json:"previous_unit_price"`
+	UnitPrice float64 `json:"unit_price,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type ReorderResponse struct{
+	Order OrderResponse `json:"order"`
+	SkippedItems []ReorderItem `json:"skipped_items"`
+	RepricedItems []ReorderItem `json:"repriced_items"`
+}
+
+type OrderTip struct{
+	Id string `json:"id"`
+	OrderId string `json:"-"`
+	CustomerId string `json:"-"`
+	Amount float64 `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type TipReport struct{
+	StartDate string `json:"start_date"`
+	EndDate string `json:"end_date"`
+	GroupBy string `json:"group_by"`
+	TipCount int `json:"tip_count"`
+	TotalTips float64 `json:"total_tips"`
+	Periods []TipReportPeriod `json:"periods"`
+}
+
+type TipReportPeriod struct{
+	Period string `json:"period"`
+	TipCount int `json:"tip_count"`
+	TotalTips float64 `json:"total_tips"`
+}
+
+type OrderStatusHistory struct{
+	Id string `json:"id"`
+	OrderId string `json:"-"`
+	FromStatus string `json:"-"`
+	ToStatus string `json:"order_status"`
+	ChangedBy string `json:"-"`
+	Note string `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type OrderStatusHistoryResponse struct{
+	FromStatus string `json:"from_status,omitempty"`
+	ToStatus string `json:"to_status"`
+	ChangedBy string `json:"changed_by,omitempty"`
+	Note string `json:"note,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+// OrderStatusTransitions lists the statuses an order can move to from its current status.
+// The first entry is the default next stage used when no target status is given.
+var OrderStatusTransitions = map[string][]string{
+	"scheduled": {"confirmed", "cancelled"},
+	"confirmed": {"preparing", "cancelled"},
+	"preparing": {"ready for pickup", "cancelled"},
+	"ready for pickup": {"out for delivery", "cancelled"},
+	"out for delivery": {"delivered", "delivery failed", "cancelled"},
+	"delivery failed": {"out for delivery", "cancelled"},
+}
+
+// ActiveOrderStatuses are the statuses of orders that are not finished yet.
+// Scheduled orders are left out until they are released to the kitchen.
+var ActiveOrderStatuses = []string{"confirmed", "preparing", "ready for pickup", "out for delivery", "delivery failed"}
+
+func IsValidOrderStatus(status string) bool{
+	if status == "scheduled" || status == "delivered" || status == "cancelled"{
+		return true
+	}
+
+	for _, s := range ActiveOrderStatuses{
+		if s == status{
+			return true
+		}
+	}
+
+	return false
+}
+
+func CanTransitionOrderStatus(from, to string) bool{
+	for _, next := range OrderStatusTransitions[from]{
+		if next == to{
+			return true
+		}
+	}
+
+	return false
+}
+
+func NextOrderStatus(from string) (string, bool){
+	next, ok := OrderStatusTransitions[from]
+	if !ok || len(next) == 0{
+		return "", false
+	}
+
+	return next[0], true
+}
 
 func (o *Order) Validate() error{
 	if o.Address == "" {
 		return config.ErrMissingFields
 	}
+
+	if len(o.OrderItems) == 0 {
+		return config.ErrEmptyOrder
+	}
 	
 	if o.TotalPrice == 0 {
 		return fmt.Errorf("failed to calculate total price")
 	}
 
-	if o.OrderStatus != "preparing" && o.OrderStatus != "out for delivery" &&  o.OrderStatus != "delivered"{
+	if !IsValidOrderStatus(o.OrderStatus){
 		return config.ErrInvalidOrderStatus
 	}
 
+	if o.TipAmount < 0 {
+		return fmt.Errorf("tip can't be negative")
+	}
+
 	for _, item := range o.OrderItems{
 		if item.Quantity <= 0 {
 			return fmt.Errorf("can't set quantity to zero or below")
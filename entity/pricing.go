@@ -0,0 +1,20 @@
+package entity
+
+type PriceQuote struct{
+	OrderItems []OrderItem `json:"order_items"`
+	Subtotal float64 `json:"subtotal"`
+	PromoCode string `json:"promo_code,omitempty"`
+	PromoApplied bool `json:"promo_applied"`
+	PromoMessage string `json:"promo_message,omitempty"`
+	DiscountAmount float64 `json:"discount_amount"`
+	DeliveryFee float64 `json:"delivery_fee"`
+	ServiceCharge float64 `json:"service_charge"`
+	TaxAmount float64 `json:"tax_amount"`
+	TaxInclusive bool `json:"tax_inclusive"`
+	Delivery DeliveryQuote `json:"delivery"`
+	TotalPrice float64 `json:"total_price"`
+	TipAmount float64 `json:"tip_amount"`
+	Balance float64 `json:"balance"`
+	RemainingBalance float64 `json:"remaining_balance"`
+	IsBalanceSufficient bool `json:"is_balance_sufficient"`
+}
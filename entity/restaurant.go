@@ -0,0 +1,205 @@
+package entity
+
+import (
+	"fmt"
+	"food-delivery-apps/config"
+	"sort"
+	"time"
+)
+
+type RestaurantSettings struct{
+	OrderingPaused bool `json:"ordering_paused"`
+	PauseReason string `json:"pause_reason"`
+	UpdatedBy string `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+type KitchenCapacity struct{
+	OrderingPaused bool `json:"ordering_paused"`
+	PauseReason string `json:"pause_reason,omitempty"`
+	IsBusy bool `json:"is_busy"`
+	QueuedOrders int `json:"queued_orders"`
+	QueuedMinutes int `json:"queued_minutes"`
+	MaxOrders int `json:"max_orders"`
+	MaxMinutes int `json:"max_minutes"`
+	SuggestedSlot string `json:"suggested_slot,omitempty"`
+}
+
+type OpeningHour struct{
+	Id string `json:"id"`
+	DayOfWeek int `json:"day_of_week"`
+	OpenTime string `json:"open_time"`
+	CloseTime string `json:"close_time"`
+}
+
+type RestaurantClosure struct{
+	Id string `json:"id"`
+	Date string `json:"date"`
+	IsClosed bool `json:"is_closed"`
+	OpenTime string `json:"open_time,omitempty"`
+	CloseTime string `json:"close_time,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type StoreStatus struct{
+	IsOpen bool `json:"is_open"`
+	OrderingPaused bool `json:"ordering_paused"`
+	PauseReason string `json:"pause_reason,omitempty"`
+	Timezone string `json:"timezone"`
+	ClosesAt string `json:"closes_at,omitempty"`
+	NextOpeningAt string `json:"next_opening_at,omitempty"`
+	ClosureReason string `json:"closure_reason,omitempty"`
+}
+
+// OpeningSchedule holds the weekly hours and the dated closures in the restaurant's timezone.
+// Without any weekly hours the restaurant is open around the clock, apart from its closures.
+type OpeningSchedule struct{
+	Hours []OpeningHour
+	Closures []RestaurantClosure
+	Location *time.Location
+}
+
+// openingPeriod is one stretch of time the restaurant is open, it ends on the next day when it runs past midnight
+type openingPeriod struct{
+	start time.Time
+	end time.Time
+}
+
+func (h *OpeningHour) Validate() error{
+	if h.OpenTime == "" || h.CloseTime == ""{
+		return config.ErrMissingFields
+	}
+
+	if h.DayOfWeek < 0 || h.DayOfWeek > 6{
+		return fmt.Errorf("day_of_week must be between 0 (Sunday) and 6 (Saturday)")
+	}
+
+	return validateOpeningTimes(h.OpenTime, h.CloseTime)
+}
+
+func (c *RestaurantClosure) Validate() error{
+	if c.Date == ""{
+		return config.ErrMissingFields
+	}
+
+	if _, err := time.Parse("2006-01-02", c.Date); err != nil{
+		return fmt.Errorf("date must use the YYYY-MM-DD format")
+	}
+
+	// A closed day has no hours, a holiday with special hours replaces the weekly hours of that day
+	if c.IsClosed{
+		c.OpenTime = ""
+		c.CloseTime = ""
+		return nil
+	}
+	if c.OpenTime == "" || c.CloseTime == ""{
+		return fmt.Errorf("open_time and close_time are required unless the restaurant is closed all day")
+	}
+
+	return validateOpeningTimes(c.OpenTime, c.CloseTime)
+}
+
+func validateOpeningTimes(openTime, closeTime string) error{
+	if _, err := time.Parse("15:04", openTime); err != nil{
+		return fmt.Errorf("open_time must use the HH:MM format")
+	}
+	if _, err := time.Parse("15:04", closeTime); err != nil{
+		return fmt.Errorf("close_time must use the HH:MM format")
+	}
+	if openTime == closeTime{
+		return fmt.Errorf("open_time and close_time can't be the same")
+	}
+
+	return nil
+}
+
+// IsOpenAt reports whether the restaurant is open at t, and when that opening period ends.
+func (s *OpeningSchedule) IsOpenAt(t time.Time) (bool, time.Time){
+	// A period that started the day before may still be running after midnight
+	day := s.startOfDay(t)
+	for _, date := range []time.Time{day.AddDate(0, 0, -1), day}{
+		for _, period := range s.periodsOn(date){
+			if !t.Before(period.start) && t.Before(period.end){
+				return true, period.end
+			}
+		}
+	}
+
+	return false, time.Time{}
+}
+
+// NextOpening returns the start of the first opening period after t, looking up to two weeks ahead.
+func (s *OpeningSchedule) NextOpening(t time.Time) (time.Time, bool){
+	day := s.startOfDay(t)
+	for i := 0; i <= 14; i++{
+		for _, period := range s.periodsOn(day.AddDate(0, 0, i)){
+			if period.start.After(t){
+				return period.start, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// ClosureOn returns the closure dated on the day of t, if any.
+func (s *OpeningSchedule) ClosureOn(t time.Time) (RestaurantClosure, bool){
+	date := t.In(s.Location).Format("2006-01-02")
+	for _, closure := range s.Closures{
+		if closure.Date == date{
+			return closure, true
+		}
+	}
+
+	return RestaurantClosure{}, false
+}
+
+// periodsOn lists the opening periods starting on date, ordered by start time.
+func (s *OpeningSchedule) periodsOn(date time.Time) []openingPeriod{
+	periods := []openingPeriod{}
+
+	// A closure on the date replaces the weekly hours
+	if closure, ok := s.ClosureOn(date); ok{
+		if !closure.IsClosed{
+			periods = append(periods, s.period(date, closure.OpenTime, closure.CloseTime))
+		}
+		return periods
+	}
+
+	// Without weekly hours the restaurant is open all day
+	if len(s.Hours) == 0{
+		return append(periods, openingPeriod{start: date, end: date.AddDate(0, 0, 1)})
+	}
+
+	for _, hour := range s.Hours{
+		if hour.DayOfWeek == int(date.Weekday()){
+			periods = append(periods, s.period(date, hour.OpenTime, hour.CloseTime))
+		}
+	}
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i].start.Before(periods[j].start)
+	})
+
+	return periods
+}
+
+func (s *OpeningSchedule) period(date time.Time, openTime, closeTime string) openingPeriod{
+	openAt, _ := time.Parse("15:04", openTime)
+	closeAt, _ := time.Parse("15:04", closeTime)
+
+	period := openingPeriod{
+		start: time.Date(date.Year(), date.Month(), date.Day(), openAt.Hour(), openAt.Minute(), 0, 0, s.Location),
+		end: time.Date(date.Year(), date.Month(), date.Day(), closeAt.Hour(), closeAt.Minute(), 0, 0, s.Location),
+	}
+	// A closing time at or before the opening time means the period runs past midnight
+	if !period.end.After(period.start){
+		period.end = period.end.AddDate(0, 0, 1)
+	}
+
+	return period
+}
+
+func (s *OpeningSchedule) startOfDay(t time.Time) time.Time{
+	t = t.In(s.Location)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, s.Location)
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+	"time"
+)
+
+type addressRepository struct{
+	db *sql.DB
+}
+
+type AddressRepository interface{
+	CreateAddress(payload entity.CustomerAddress) (entity.CustomerAddressResponse, error)
+	GetAddresses(customerId string) ([]entity.CustomerAddressResponse, error)
+	GetAddressById(id, customerId string) (entity.CustomerAddressResponse, error)
+	UpdateAddress(payload entity.CustomerAddress) (entity.CustomerAddressResponse, error)
+	DeleteAddress(id, customerId string) error
+}
+
+func (r *addressRepository) CreateAddress(payload entity.CustomerAddress) (entity.CustomerAddressResponse, error){
+	// Insert the value for customer_addresses
+	if err := r.db.QueryRow(config.CreateCustomerAddressQuery, payload.CustomerId, nullString(payload.Label), payload.Address,
+		payload.Latitude, payload.Longitude).Scan(&payload.Id, &payload.CreatedAt); err != nil{
+		return entity.CustomerAddressResponse{}, fmt.Errorf("failed to create address: %v", err.Error())
+	}
+
+	// Construct the response object with formatted data.
+	response := entity.CustomerAddressResponse{
+		Id: payload.Id,
+		Label: payload.Label,
+		Address: payload.Address,
+		Latitude: payload.Latitude,
+		Longitude: payload.Longitude,
+		CreatedAt: payload.CreatedAt.Format("January 02, 2006 03:04 PM"),
+	}
+
+	return response, nil
+}
+
+func (r *addressRepository) GetAddresses(customerId string) ([]entity.CustomerAddressResponse, error){
+	addresses := []entity.CustomerAddressResponse{}
+
+	// Retrieve the customer's saved addresses
+	rows, err := r.db.Query(config.GetCustomerAddressesQuery, customerId)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve addresses: %v", err.Error())
+	}
+	defer rows.Close()
+
+	// Iterate over the rows from the database, scanning each row into a address object.
+	for rows.Next(){
+		var address entity.CustomerAddressResponse
+		var createdAt time.Time
+		var updatedAt sql.NullTime
+
+		if err := rows.Scan(&address.Id, &address.Label, &address.Address, &address.Latitude, &address.Longitude,
+			&createdAt, &updatedAt); err != nil{
+			return nil, fmt.Errorf("failed to scan address: %v", err.Error())
+		}
+
+		// Format the timestamps for the response in a readable format.
+		address.CreatedAt = createdAt.Format("January 02, 2006 03:04 PM")
+		address.UpdatedAt = formatNullTime(updatedAt)
+
+		addresses = append(addresses, address)
+	}
+
+	return addresses, nil
+}
+
+func (r *addressRepository) GetAddressById(id, customerId string) (entity.CustomerAddressResponse, error){
+	var address entity.CustomerAddressResponse
+	var createdAt time.Time
+	var updatedAt sql.NullTime
+
+	// Retrieve the address, it must belong to the customer
+	err := r.db.QueryRow(config.GetCustomerAddressByIdQuery, id, customerId).Scan(&address.Id, &address.Label, &address.Address,
+		&address.Latitude, &address.Longitude, &createdAt, &updatedAt)
+	if err != nil{
+		if err == sql.ErrNoRows{
+			return entity.CustomerAddressResponse{}, fmt.Errorf("address with id %s is not found", id)
+		}
+		return entity.CustomerAddressResponse{}, fmt.Errorf("failed to retrieve address: %v", err.Error())
+	}
+
+	// Format the timestamps for the response in a readable format.
+	address.CreatedAt = createdAt.Format("January 02, 2006 03:04 PM")
+	address.UpdatedAt = formatNullTime(updatedAt)
+
+	return address, nil
+}
+
+func (r *addressRepository) UpdateAddress(payload entity.CustomerAddress) (entity.CustomerAddressResponse, error){
+	// Update the address, it must belong to the customer
+	err := r.db.QueryRow(config.UpdateCustomerAddressQuery, payload.Id, payload.CustomerId, nullString(payload.Label), payload.Address,
+		payload.Latitude, payload.Longitude, payload.UpdatedAt).Scan(&payload.CreatedAt)
+	if err != nil{
+		if err == sql.ErrNoRows{
+			return entity.CustomerAddressResponse{}, fmt.Errorf("address with id %s is not found", payload.Id)
+		}
+		return entity.CustomerAddressResponse{}, fmt.Errorf("failed to update address: %v", err.Error())
+	}
+
+	// Construct the response object with formatted data.
+	response := entity.CustomerAddressResponse{
+		Id: payload.Id,
+		Label: payload.Label,
+		Address: payload.Address,
+		Latitude: payload.Latitude,
+		Longitude: payload.Longitude,
+		CreatedAt: payload.CreatedAt.Format("January 02, 2006 03:04 PM"),
+		UpdatedAt: payload.UpdatedAt.Format("January 02, 2006 03:04 PM"),
+	}
+
+	return response, nil
+}
+
+func (r *addressRepository) DeleteAddress(id, customerId string) error{
+	result, err := r.db.Exec(config.DeleteCustomerAddressQuery, id, customerId)
+	if err != nil{
+		return fmt.Errorf("failed to delete address: %v", err.Error())
+	}
+
+	// Ensure the address exists
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0{
+		return fmt.Errorf("address with id %s is not found", id)
+	}
+
+	return nil
+}
+
+func NewAddressRepository(db *sql.DB) AddressRepository{
+	return &addressRepository{db: db}
+}
@@ -8,22 +8,31 @@ import (
 	"food-delivery-apps/shared/model"
 	"math"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 type balanceRepository struct {
-	db *sql.DB
+	db DBTX
 }
 
 type BalanceRepository interface{
 	CreateBalance(payload entity.Balance) (entity.BalanceResponse, error)
 	GetBalanceData(page, size int, customerId string) ([]entity.BalanceResponse, model.Paging, error)
 	GetUserBalance(customerId string) (float64, error)
+	LockUserBalance(customerId string) (float64, error)
+	UpdateUserBalance(customerId string, balance float64) error
 }
 
 func (r *balanceRepository) CreateBalance(payload entity.Balance) (entity.BalanceResponse, error){
 	// Insert the value for balances
 	if err := r.db.QueryRow(config.CreateBalanceQuery, payload.CustomerId, payload.TransactionType,
 		payload.Amount, payload.Description, payload.Balance).Scan(&payload.Id, &payload.Balance, &payload.CreatedAt); err != nil{
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23514" { // Check constraint violation
+				return entity.BalanceResponse{}, config.ErrInsufficientBalance
+			}
+		}
 		return entity.BalanceResponse{}, fmt.Errorf("failed to create balance: %v", err.Error())
 	}
 	
@@ -100,9 +109,9 @@ func (r *balanceRepository) GetUserBalance(customerId string) (float64, error){
 
 	// Handle potential errors from the query
 	if err != nil{
-		// If no rows are found, return a specific "user not found" error message
+		// A customer without a wallet yet has never topped up, so the balance is zero
 		if err == sql.ErrNoRows{
-			return 0, fmt.Errorf("balance with customerId %s is not found: %v", customerId, err.Error())
+			return 0, nil
 		}
 		// For other errors, return a general retrieval failure message
 		return 0, fmt.Errorf("failed to retrieve user balance; %v", err.Error())
@@ -111,6 +120,37 @@ func (r *balanceRepository) GetUserBalance(customerId string) (float64, error){
 	return balance, nil
 }
 
+func (r *balanceRepository) LockUserBalance(customerId string) (float64, error){
+	var balance float64
+
+	// Make sure the customer's wallet row exists before locking it
+	if _, err := r.db.Exec(config.CreateWalletQuery, customerId); err != nil{
+		return 0, fmt.Errorf("failed to create wallet: %v", err.Error())
+	}
+
+	// Retrieve and lock the wallet row until the surrounding transaction ends,
+	// so concurrent top-ups and orders for the same customer are serialized.
+	if err := r.db.QueryRow(config.LockUserBalanceQuery, customerId).Scan(&balance); err != nil{
+		return 0, fmt.Errorf("failed to lock user balance: %v", err.Error())
+	}
+
+	return balance, nil
+}
+
+func (r *balanceRepository) UpdateUserBalance(customerId string, balance float64) error{
+	_, err := r.db.Exec(config.UpdateUserBalanceQuery, customerId, balance)
+	if err != nil{
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23514" { // Check constraint violation
+				return config.ErrInsufficientBalance
+			}
+		}
+		return fmt.Errorf("failed to update user balance: %v", err.Error())
+	}
+
+	return nil
+}
+
 func NewBalanceRepository(db *sql.DB) BalanceRepository{
 	return &balanceRepository{db: db}
 }
\ No newline at end of file
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+
+	"github.com/lib/pq"
+)
+
+type cartRepository struct{
+	db *sql.DB
+}
+
+type CartRepository interface{
+	AddCartItem(payload entity.CartItem) (entity.CartItem, error)
+	GetCartItems(customerId string) ([]entity.CartItem, error)
+	GetCartItem(customerId, id string) (entity.CartItem, error)
+	UpdateCartItem(payload entity.CartItem) error
+	DeleteCartItem(customerId, id string) error
+	ClearCart(customerId string) error
+}
+
+func (r *cartRepository) AddCartItem(payload entity.CartItem) (entity.CartItem, error){
+	// Insert the item, or add the quantity to the line already in the cart
+	err := r.db.QueryRow(config.AddCartItemQuery, payload.CustomerId, payload.MenuId, payload.MenuName,
+		payload.Quantity, pq.Array(payload.OptionIds), payload.Price).Scan(&payload.Id, &payload.Quantity, &payload.CreatedAt, &payload.UpdatedAt)
+	if err != nil{
+		return entity.CartItem{}, fmt.Errorf("failed to add cart item: %v", err.Error())
+	}
+
+	return payload, nil
+}
+
+func (r *cartRepository) GetCartItems(customerId string) ([]entity.CartItem, error){
+	var items []entity.CartItem
+
+	// Retrieve the customer's cart items
+	rows, err := r.db.Query(config.GetCartItemsQuery, customerId)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve cart: %v", err.Error())
+	}
+	defer rows.Close()
+
+	// Iterate over the rows from the database, scanning each row into a cart item object.
+	for rows.Next(){
+		item := entity.CartItem{CustomerId: customerId}
+		if err := rows.Scan(&item.Id, &item.MenuId, &item.MenuName, &item.Quantity,
+			pq.Array(&item.OptionIds), &item.Price, &item.CreatedAt, &item.UpdatedAt); err != nil{
+			return nil, fmt.Errorf("failed to scan cart item: %v", err.Error())
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (r *cartRepository) GetCartItem(customerId, id string) (entity.CartItem, error){
+	item := entity.CartItem{CustomerId: customerId}
+
+	// Retrieve a line of the customer's cart by id
+	err := r.db.QueryRow(config.GetCartItemQuery, customerId, id).Scan(&item.Id, &item.MenuId, &item.MenuName, &item.Quantity,
+		pq.Array(&item.OptionIds), &item.Price, &item.CreatedAt, &item.UpdatedAt)
+	if err != nil{
+		if err == sql.ErrNoRows{
+			return entity.CartItem{}, config.ErrCartItemNotFound
+		}
+		return entity.CartItem{}, fmt.Errorf("failed to retrieve cart item: %v", err.Error())
+	}
+
+	return item, nil
+}
+
+func (r *cartRepository) UpdateCartItem(payload entity.CartItem) error{
+	result, err := r.db.Exec(config.UpdateCartItemQuery, payload.CustomerId, payload.Id,
+		payload.MenuName, payload.Quantity, pq.Array(payload.OptionIds), payload.Price)
+	if err != nil{
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23514" { // Check constraint violation
+				return fmt.Errorf("can't set quantity to zero or below")
+			}
+			if pqErr.Code == "23505" && pqErr.Constraint == "unique_cart_item" { // Unique violation
+				return fmt.Errorf("the cart already has %s with these options, update that line instead", payload.MenuName)
+			}
+		}
+		return fmt.Errorf("failed to update cart item: %v", err.Error())
+	}
+
+	// Ensure the line was in the cart
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0{
+		return config.ErrCartItemNotFound
+	}
+
+	return nil
+}
+
+func (r *cartRepository) DeleteCartItem(customerId, id string) error{
+	result, err := r.db.Exec(config.DeleteCartItemQuery, customerId, id)
+	if err != nil{
+		return fmt.Errorf("failed to delete cart item: %v", err.Error())
+	}
+
+	// Ensure the line was in the cart
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0{
+		return config.ErrCartItemNotFound
+	}
+
+	return nil
+}
+
+func (r *cartRepository) ClearCart(customerId string) error{
+	_, err := r.db.Exec(config.ClearCartQuery, customerId)
+	if err != nil{
+		return fmt.Errorf("failed to clear cart: %v", err.Error())
+	}
+
+	return nil
+}
+
+func NewCartRepository(db *sql.DB) CartRepository{
+	return &cartRepository{db: db}
+}
@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+
+	"github.com/lib/pq"
+)
+
+type categoryRepository struct{
+	db *sql.DB
+}
+
+type CategoryRepository interface{
+	CreateMenuCategory(payload entity.MenuCategory) (entity.MenuCategory, error)
+	GetMenuCategories(activeOnly bool) ([]entity.MenuCategory, error)
+	GetMenuCategoryById(id string) (entity.MenuCategory, error)
+	GetActiveMenuCategory(category string) (entity.MenuCategory, error)
+	UpdateMenuCategory(payload entity.MenuCategory) (entity.MenuCategory, error)
+	DeleteMenuCategory(id string) error
+	CreateUnitType(payload entity.UnitType) (entity.UnitType, error)
+	GetUnitTypes() ([]entity.UnitType, error)
+	GetUnitTypeById(id string) (entity.UnitType, error)
+	UpdateUnitType(payload entity.UnitType) (entity.UnitType, error)
+	DeleteUnitType(id string) error
+}
+
+func (r *categoryRepository) CreateMenuCategory(payload entity.MenuCategory) (entity.MenuCategory, error){
+	// Insert the value for menu_categories
+	if err := r.db.QueryRow(config.CreateMenuCategoryQuery, payload.Name, nullString(payload.Desc), payload.DisplayOrder,
+		*payload.IsActive, payload.UpdatedAt).Scan(&payload.Id, &payload.CreatedAt); err != nil{
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23505" && pqErr.Constraint == "unique_menu_category_name" { // Unique violation
+				return entity.MenuCategory{}, fmt.Errorf("menu category %s already exists", payload.Name)
+			}
+		}
+		return entity.MenuCategory{}, fmt.Errorf("failed to create menu category: %v", err.Error())
+	}
+
+	return payload, nil
+}
+
+func (r *categoryRepository) GetMenuCategories(activeOnly bool) ([]entity.MenuCategory, error){
+	categories := []entity.MenuCategory{}
+
+	// Retrieve every category in display order, or only the active ones
+	query := config.GetMenuCategoriesQuery
+	if activeOnly{
+		query = config.GetActiveMenuCategoriesQuery
+	}
+	rows, err := r.db.Query(query)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve menu categories: %v", err.Error())
+	}
+	defer rows.Close()
+
+	// Iterate over the rows from the database, scanning each row into a category object.
+	for rows.Next(){
+		var category entity.MenuCategory
+		if err := rows.Scan(&category.Id, &category.Name, &category.Desc, &category.DisplayOrder, &category.IsActive); err != nil{
+			return nil, fmt.Errorf("failed to scan menu category: %v", err.Error())
+		}
+		categories = append(categories, category)
+	}
+
+	return categories, nil
+}
+
+func (r *categoryRepository) GetMenuCategoryById(id string) (entity.MenuCategory, error){
+	var category entity.MenuCategory
+
+	// Retrieve category by id
+	err := r.db.QueryRow(config.GetMenuCategoryByIdQuery, id).Scan(&category.Id, &category.Name, &category.Desc,
+		&category.DisplayOrder, &category.IsActive)
+	if err != nil{
+		if err == sql.ErrNoRows{
+			return entity.MenuCategory{}, fmt.Errorf("menu category with id %s is not found", id)
+		}
+		return entity.MenuCategory{}, fmt.Errorf("failed to retrieve menu category: %v", err.Error())
+	}
+
+	return category, nil
+}
+
+func (r *categoryRepository) GetActiveMenuCategory(category string) (entity.MenuCategory, error){
+	var payload entity.MenuCategory
+
+	// Retrieve an active category by its id or by its name, ignoring case.
+	// The id is compared as text, so a value that isn't a uuid simply finds nothing.
+	err := r.db.QueryRow(config.GetActiveMenuCategoryByIdOrNameQuery, category).Scan(&payload.Id, &payload.Name, &payload.Desc,
+		&payload.DisplayOrder, &payload.IsActive)
+	if err != nil{
+		if err == sql.ErrNoRows{
+			return entity.MenuCategory{}, fmt.Errorf("%w: %s", config.ErrUnknownMenuCategory, category)
+		}
+		return entity.MenuCategory{}, fmt.Errorf("failed to retrieve menu category: %v", err.Error())
+	}
+
+	return payload, nil
+}
+
+func (r *categoryRepository) UpdateMenuCategory(payload entity.MenuCategory) (entity.MenuCategory, error){
+	_, err := r.db.Exec(config.UpdateMenuCategoryQuery, payload.Id, payload.Name, nullString(payload.Desc), payload.DisplayOrder,
+		*payload.IsActive, payload.UpdatedAt)
+	if err != nil{
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23505" && pqErr.Constraint == "unique_menu_category_name" { // Unique violation
+				return entity.MenuCategory{}, fmt.Errorf("menu category %s already exists", payload.Name)
+			}
+		}
+		return entity.MenuCategory{}, fmt.Errorf("failed to update menu category: %v", err.Error())
+	}
+
+	return payload, nil
+}
+
+func (r *categoryRepository) DeleteMenuCategory(id string) error{
+	result, err := r.db.Exec(config.DeleteMenuCategoryQuery, id)
+	if err != nil{
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23503" { // Foreign key violation
+				return fmt.Errorf("menu category is still used by menus, deactivate it instead")
+			}
+		}
+		return fmt.Errorf("failed to delete menu category: %v", err.Error())
+	}
+
+	// Ensure the category exists
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0{
+		return fmt.Errorf("menu category with id %s is not found", id)
+	}
+
+	return nil
+}
+
+func (r *categoryRepository) CreateUnitType(payload entity.UnitType) (entity.UnitType, error){
+	// Insert the value for unit_types
+	if err := r.db.QueryRow(config.CreateUnitTypeQuery, payload.Name, nullString(payload.Desc), payload.DisplayOrder,
+		*payload.IsActive, payload.UpdatedAt).Scan(&payload.Id, &payload.CreatedAt); err != nil{
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23505" && pqErr.Constraint == "unique_unit_type_name" { // Unique violation
+				return entity.UnitType{}, fmt.Errorf("unit type %s already exists", payload.Name)
+			}
+		}
+		return entity.UnitType{}, fmt.Errorf("failed to create unit type: %v", err.Error())
+	}
+
+	return payload, nil
+}
+
+func (r *categoryRepository) GetUnitTypes() ([]entity.UnitType, error){
+	unitTypes := []entity.UnitType{}
+
+	// Retrieve every unit type in display order
+	rows, err := r.db.Query(config.GetUnitTypesQuery)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve unit types: %v", err.Error())
+	}
+	defer rows.Close()
+
+	// Iterate over the rows from the database, scanning each row into a unit type object.
+	for rows.Next(){
+		var unitType entity.UnitType
+		if err := rows.Scan(&unitType.Id, &unitType.Name, &unitType.Desc, &unitType.DisplayOrder, &unitType.IsActive); err != nil{
+			return nil, fmt.Errorf("failed to scan unit type: %v", err.Error())
+		}
+		unitTypes = append(unitTypes, unitType)
+	}
+
+	return unitTypes, nil
+}
+
+func (r *categoryRepository) GetUnitTypeById(id string) (entity.UnitType, error){
+	var unitType entity.UnitType
+
+	// Retrieve unit type by id
+	err := r.db.QueryRow(config.GetUnitTypeByIdQuery, id).Scan(&unitType.Id, &unitType.Name, &unitType.Desc,
+		&unitType.DisplayOrder, &unitType.IsActive)
+	if err != nil{
+		if err == sql.ErrNoRows{
+			return entity.UnitType{}, fmt.Errorf("unit type with id %s is not found", id)
+		}
+		return entity.UnitType{}, fmt.Errorf("failed to retrieve unit type: %v", err.Error())
+	}
+
+	return unitType, nil
+}
+
+func (r *categoryRepository) UpdateUnitType(payload entity.UnitType) (entity.UnitType, error){
+	_, err := r.db.Exec(config.UpdateUnitTypeQuery, payload.Id, payload.Name, nullString(payload.Desc), payload.DisplayOrder,
+		*payload.IsActive, payload.UpdatedAt)
+	if err != nil{
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23505" && pqErr.Constraint == "unique_unit_type_name" { // Unique violation
+				return entity.UnitType{}, fmt.Errorf("unit type %s already exists", payload.Name)
+			}
+		}
+		return entity.UnitType{}, fmt.Errorf("failed to update unit type: %v", err.Error())
+	}
+
+	return payload, nil
+}
+
+func (r *categoryRepository) DeleteUnitType(id string) error{
+	result, err := r.db.Exec(config.DeleteUnitTypeQuery, id)
+	if err != nil{
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23503" { // Foreign key violation
+				return fmt.Errorf("unit type is still used by menus, deactivate it instead")
+			}
+		}
+		return fmt.Errorf("failed to delete unit type: %v", err.Error())
+	}
+
+	// Ensure the unit type exists
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0{
+		return fmt.Errorf("unit type with id %s is not found", id)
+	}
+
+	return nil
+}
+
+func NewCategoryRepository(db *sql.DB) CategoryRepository{
+	return &categoryRepository{db: db}
+}
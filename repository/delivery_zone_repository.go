@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+
+	"github.com/lib/pq"
+)
+
+type deliveryZoneRepository struct{
+	db DBTX
+}
+
+type DeliveryZoneRepository interface{
+	CreateDeliveryZone(payload entity.DeliveryZone) (entity.DeliveryZone, error)
+	GetAllDeliveryZones() ([]entity.DeliveryZone, error)
+	DeleteDeliveryZone(id string) error
+}
+
+func (r *deliveryZoneRepository) CreateDeliveryZone(payload entity.DeliveryZone) (entity.DeliveryZone, error){
+	// Radius zones have no polygon and polygon zones have no radius
+	var radiusKm sql.NullFloat64
+	var polygon sql.NullString
+	if payload.ZoneType == "radius"{
+		radiusKm = sql.NullFloat64{Float64: payload.RadiusKm, Valid: true}
+	} else {
+		encoded, err := json.Marshal(payload.Polygon)
+		if err != nil{
+			return entity.DeliveryZone{}, fmt.Errorf("failed to encode polygon: %v", err.Error())
+		}
+		polygon = sql.NullString{String: string(encoded), Valid: true}
+	}
+
+	// Insert the value for delivery_zones
+	if err := r.db.QueryRow(config.CreateDeliveryZoneQuery, payload.Name, payload.ZoneType, radiusKm,
+		polygon).Scan(&payload.Id, &payload.CreatedAt); err != nil{
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23505" && pqErr.Constraint == "unique_delivery_zone_name" { // Unique violation
+				return entity.DeliveryZone{}, fmt.Errorf("delivery zone %s already exists", payload.Name)
+			}
+		}
+		return entity.DeliveryZone{}, fmt.Errorf("failed to create delivery zone: %v", err.Error())
+	}
+
+	// Insert each tier of the fee table
+	for i := range payload.Fees{
+		payload.Fees[i].ZoneId = payload.Id
+		if err := r.db.QueryRow(config.CreateDeliveryZoneFeeQuery, payload.Id, payload.Fees[i].MaxDistanceKm,
+			payload.Fees[i].Fee).Scan(&payload.Fees[i].Id); err != nil{
+			if pqErr, ok := err.(*pq.Error); ok {
+				if pqErr.Code == "23505" && pqErr.Constraint == "unique_delivery_zone_fee" { // Unique violation
+					return entity.DeliveryZone{}, fmt.Errorf("fee tiers of a zone must have different max_distance_km")
+				}
+			}
+			return entity.DeliveryZone{}, fmt.Errorf("failed to create delivery zone fee: %v", err.Error())
+		}
+	}
+
+	return payload, nil
+}
+
+func (r *deliveryZoneRepository) GetAllDeliveryZones() ([]entity.DeliveryZone, error){
+	zones := []entity.DeliveryZone{}
+
+	// Retrieve every zone with its fee table in one query
+	rows, err := r.db.Query(config.GetAllDeliveryZonesQuery)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve delivery zones: %v", err.Error())
+	}
+	defer rows.Close()
+
+	// Rows come ordered by zone, so fee tiers are appended to the last zone
+	for rows.Next(){
+		var zone entity.DeliveryZone
+		var fee entity.DeliveryZoneFee
+		var polygon []byte
+
+		if err := rows.Scan(&zone.Id, &zone.Name, &zone.ZoneType, &zone.RadiusKm, &polygon,
+			&fee.Id, &fee.MaxDistanceKm, &fee.Fee); err != nil{
+			return nil, fmt.Errorf("failed to scan delivery zone: %v", err.Error())
+		}
+		fee.ZoneId = zone.Id
+
+		if len(zones) == 0 || zones[len(zones)-1].Id != zone.Id{
+			if len(polygon) > 0{
+				if err := json.Unmarshal(polygon, &zone.Polygon); err != nil{
+					return nil, fmt.Errorf("failed to decode polygon: %v", err.Error())
+				}
+			}
+			zones = append(zones, zone)
+		}
+		last := &zones[len(zones)-1]
+		last.Fees = append(last.Fees, fee)
+	}
+
+	return zones, nil
+}
+
+func (r *deliveryZoneRepository) DeleteDeliveryZone(id string) error{
+	result, err := r.db.Exec(config.DeleteDeliveryZoneQuery, id)
+	if err != nil{
+		return fmt.Errorf("failed to delete delivery zone: %v", err.Error())
+	}
+
+	// Ensure the delivery zone exists
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0{
+		return fmt.Errorf("delivery zone with id %s is not found", id)
+	}
+
+	return nil
+}
+
+func NewDeliveryZoneRepository(db *sql.DB) DeliveryZoneRepository{
+	return &deliveryZoneRepository{db: db}
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+	"time"
+)
+
+type idempotencyRepository struct{
+	db *sql.DB
+}
+
+type IdempotencyRepository interface{
+	CreateKey(payload entity.IdempotencyKey) (entity.IdempotencyKey, bool, error)
+	GetKey(userId, key string) (entity.IdempotencyKey, error)
+	SaveResponse(id string, statusCode int, body []byte) error
+	DeleteKey(id string) error
+	ReclaimKey(id string, timeout time.Duration) (time.Time, bool, error)
+	DeleteExpiredKeys(ttl time.Duration) (int64, error)
+}
+
+func (r *idempotencyRepository) CreateKey(payload entity.IdempotencyKey) (entity.IdempotencyKey, bool, error){
+	// Insert the key, nothing is returned when the customer already used it
+	err := r.db.QueryRow(config.CreateIdempotencyKeyQuery, payload.UserId, payload.Key,
+		payload.RequestHash).Scan(&payload.Id, &payload.CreatedAt)
+	if err != nil{
+		if err == sql.ErrNoRows{
+			return entity.IdempotencyKey{}, false, nil
+		}
+		return entity.IdempotencyKey{}, false, fmt.Errorf("failed to create idempotency key: %v", err.Error())
+	}
+
+	return payload, true, nil
+}
+
+func (r *idempotencyRepository) GetKey(userId, key string) (entity.IdempotencyKey, error){
+	payload := entity.IdempotencyKey{UserId: userId, Key: key}
+
+	err := r.db.QueryRow(config.GetIdempotencyKeyQuery, userId, key).Scan(&payload.Id, &payload.RequestHash,
+		&payload.StatusCode, &payload.ResponseBody, &payload.CreatedAt)
+	if err != nil{
+		if err == sql.ErrNoRows{
+			return entity.IdempotencyKey{}, fmt.Errorf("idempotency key %s is not found", key)
+		}
+		return entity.IdempotencyKey{}, fmt.Errorf("failed to retrieve idempotency key: %v", err.Error())
+	}
+
+	return payload, nil
+}
+
+func (r *idempotencyRepository) SaveResponse(id string, statusCode int, body []byte) error{
+	if _, err := r.db.Exec(config.SaveIdempotencyResponseQuery, id, statusCode, body); err != nil{
+		return fmt.Errorf("failed to save idempotent response: %v", err.Error())
+	}
+
+	return nil
+}
+
+func (r *idempotencyRepository) DeleteKey(id string) error{
+	if _, err := r.db.Exec(config.DeleteIdempotencyKeyQuery, id); err != nil{
+		return fmt.Errorf("failed to delete idempotency key: %v", err.Error())
+	}
+
+	return nil
+}
+
+func (r *idempotencyRepository) ReclaimKey(id string, timeout time.Duration) (time.Time, bool, error){
+	// Take over a claim that is still without a response after the timeout, measured by the database clock.
+	// Only one retry can win the update, the others see the fresh claim as still in progress.
+	var createdAt time.Time
+	err := r.db.QueryRow(config.ReclaimIdempotencyKeyQuery, id, int64(timeout.Seconds())).Scan(&createdAt)
+	if err != nil{
+		if err == sql.ErrNoRows{
+			return time.Time{}, false, nil
+		}
+		return time.Time{}, false, fmt.Errorf("failed to reclaim idempotency key: %v", err.Error())
+	}
+
+	return createdAt, true, nil
+}
+
+func (r *idempotencyRepository) DeleteExpiredKeys(ttl time.Duration) (int64, error){
+	// Delete the keys older than the ttl, measured by the database clock
+	result, err := r.db.Exec(config.DeleteExpiredIdempotencyKeyQuery, int64(ttl.Seconds()))
+	if err != nil{
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %v", err.Error())
+	}
+
+	// Get the number of rows affected by the delete operation
+	affectedRows, err := result.RowsAffected()
+	if err != nil{
+		return 0, fmt.Errorf("failed to get affected rows: %v", err.Error())
+	}
+
+	return affectedRows, nil
+}
+
+func NewIdempotencyRepository(db *sql.DB) IdempotencyRepository{
+	return &idempotencyRepository{db: db}
+}
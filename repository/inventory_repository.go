@@ -0,0 +1,256 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+	"food-delivery-apps/shared/model"
+	"math"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type inventoryRepository struct{
+	db DBTX
+}
+
+type InventoryRepository interface{
+	CreateIngredient(payload entity.Ingredient) (entity.Ingredient, error)
+	GetIngredients() ([]entity.Ingredient, error)
+	GetIngredientById(id string) (entity.Ingredient, error)
+	UpdateIngredient(payload entity.Ingredient) (entity.Ingredient, error)
+	AdjustQuantity(id string, quantity float64, updatedAt time.Time) (entity.Ingredient, error)
+	CreateStockMovement(payload entity.StockMovement) (entity.StockMovement, error)
+	GetStockMovements(ingredientId string, page, size int) ([]entity.StockMovement, model.Paging, error)
+	ConsumeOrderIngredients(orderId, changedBy string, consumedAt time.Time) error
+	GetRecipe(menuId string) ([]entity.RecipeItem, error)
+	SetRecipe(payload entity.Recipe) error
+	GetLowStockIngredients() ([]entity.LowStockIngredient, error)
+}
+
+func (r *inventoryRepository) CreateIngredient(payload entity.Ingredient) (entity.Ingredient, error){
+	// Insert the value for ingredients
+	if err := r.db.QueryRow(config.CreateIngredientQuery, payload.Name, payload.Unit, payload.ReorderThreshold,
+		payload.UpdatedAt).Scan(&payload.Id, &payload.CreatedAt); err != nil{
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23505" && pqErr.Constraint == "unique_ingredient_name" { // Unique violation
+				return entity.Ingredient{}, fmt.Errorf("ingredient %s already exists", payload.Name)
+			}
+		}
+		return entity.Ingredient{}, fmt.Errorf("failed to create ingredient: %v", err.Error())
+	}
+
+	return payload, nil
+}
+
+func (r *inventoryRepository) GetIngredients() ([]entity.Ingredient, error){
+	ingredients := []entity.Ingredient{}
+
+	// Retrieve every ingredient ordered by name
+	rows, err := r.db.Query(config.GetIngredientsQuery)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve ingredients: %v", err.Error())
+	}
+	defer rows.Close()
+
+	// Iterate over the rows from the database, scanning each row into an ingredient object.
+	for rows.Next(){
+		var ingredient entity.Ingredient
+		if err := rows.Scan(&ingredient.Id, &ingredient.Name, &ingredient.Unit, &ingredient.QuantityOnHand,
+			&ingredient.ReorderThreshold, &ingredient.CreatedAt, &ingredient.UpdatedAt); err != nil{
+			return nil, fmt.Errorf("failed to scan ingredient: %v", err.Error())
+		}
+		ingredients = append(ingredients, ingredient)
+	}
+
+	return ingredients, nil
+}
+
+func (r *inventoryRepository) GetIngredientById(id string) (entity.Ingredient, error){
+	var ingredient entity.Ingredient
+
+	// Retrieve ingredient by id
+	err := r.db.QueryRow(config.GetIngredientByIdQuery, id).Scan(&ingredient.Id, &ingredient.Name, &ingredient.Unit,
+		&ingredient.QuantityOnHand, &ingredient.ReorderThreshold, &ingredient.CreatedAt, &ingredient.UpdatedAt)
+	if err != nil{
+		if err == sql.ErrNoRows{
+			return entity.Ingredient{}, fmt.Errorf("ingredient with id %s is not found", id)
+		}
+		return entity.Ingredient{}, fmt.Errorf("failed to retrieve ingredient: %v", err.Error())
+	}
+
+	return ingredient, nil
+}
+
+func (r *inventoryRepository) UpdateIngredient(payload entity.Ingredient) (entity.Ingredient, error){
+	_, err := r.db.Exec(config.UpdateIngredientQuery, payload.Id, payload.Name, payload.Unit, payload.ReorderThreshold, payload.UpdatedAt)
+	if err != nil{
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23505" && pqErr.Constraint == "unique_ingredient_name" { // Unique violation
+				return entity.Ingredient{}, fmt.Errorf("ingredient %s already exists", payload.Name)
+			}
+		}
+		return entity.Ingredient{}, fmt.Errorf("failed to update ingredient: %v", err.Error())
+	}
+
+	return payload, nil
+}
+
+func (r *inventoryRepository) AdjustQuantity(id string, quantity float64, updatedAt time.Time) (entity.Ingredient, error){
+	var ingredient entity.Ingredient
+
+	// Add the quantity in place, so concurrent movements can't overwrite each other
+	err := r.db.QueryRow(config.AdjustIngredientQuantityQuery, id, quantity, updatedAt).Scan(&ingredient.Id, &ingredient.Name,
+		&ingredient.Unit, &ingredient.QuantityOnHand, &ingredient.ReorderThreshold, &ingredient.CreatedAt, &ingredient.UpdatedAt)
+	if err != nil{
+		if err == sql.ErrNoRows{
+			return entity.Ingredient{}, fmt.Errorf("ingredient with id %s is not found", id)
+		}
+		return entity.Ingredient{}, fmt.Errorf("failed to update ingredient quantity: %v", err.Error())
+	}
+
+	return ingredient, nil
+}
+
+func (r *inventoryRepository) CreateStockMovement(payload entity.StockMovement) (entity.StockMovement, error){
+	var createdAt time.Time
+
+	// Insert the value for stock_movements
+	if err := r.db.QueryRow(config.CreateStockMovementQuery, payload.IngredientId, payload.MovementType, payload.Quantity,
+		nullString(payload.OrderId), nullString(payload.Note), nullString(payload.CreatedBy)).Scan(&payload.Id, &createdAt); err != nil{
+		return entity.StockMovement{}, fmt.Errorf("failed to record stock movement: %v", err.Error())
+	}
+	payload.CreatedAt = createdAt.Format("January 02, 2006 03:04 PM")
+
+	return payload, nil
+}
+
+func (r *inventoryRepository) GetStockMovements(ingredientId string, page, size int) ([]entity.StockMovement, model.Paging, error){
+	movements := []entity.StockMovement{}
+
+	// Calculate the offset for pagination based on the current page and page size.
+	offset := (page - 1) * size
+
+	// Retrieve the movements of the ingredient, latest first
+	rows, err := r.db.Query(config.GetStockMovementsQuery, ingredientId, size, offset)
+	if err != nil{
+		return nil, model.Paging{}, fmt.Errorf("failed to retrieve stock movements: %v", err.Error())
+	}
+	defer rows.Close()
+
+	for rows.Next(){
+		var movement entity.StockMovement
+		var orderId, note sql.NullString
+		var createdAt time.Time
+
+		if err := rows.Scan(&movement.Id, &movement.MovementType, &movement.Quantity, &orderId, &note, &createdAt); err != nil{
+			return nil, model.Paging{}, fmt.Errorf("failed to scan stock movement: %v", err.Error())
+		}
+		movement.IngredientId = ingredientId
+		movement.OrderId = orderId.String
+		movement.Note = note.String
+		movement.CreatedAt = createdAt.Format("January 02, 2006 03:04 PM")
+
+		movements = append(movements, movement)
+	}
+
+	// Count the total number of movements to set up paging information.
+	totalRows := 0
+	if err := r.db.QueryRow(config.CountStockMovementQuery, ingredientId).Scan(&totalRows); err != nil{
+		return nil, model.Paging{}, fmt.Errorf("failed to count stock movements: %v", err.Error())
+	}
+
+	paging := model.Paging{
+		Page: page,
+		RowsPerPage: size,
+		TotalRows: totalRows,
+		TotalPages: int(math.Ceil(float64(totalRows) / float64(size))),
+	}
+
+	return movements, paging, nil
+}
+
+func (r *inventoryRepository) ConsumeOrderIngredients(orderId, changedBy string, consumedAt time.Time) error{
+	// Take the recipe ingredients of every order item off the stock
+	if _, err := r.db.Exec(config.ConsumeOrderIngredientsQuery, orderId, consumedAt); err != nil{
+		return fmt.Errorf("failed to consume ingredients: %v", err.Error())
+	}
+
+	// Record one consumption movement per ingredient
+	if _, err := r.db.Exec(config.CreateConsumptionMovementsQuery, orderId, nullString(changedBy)); err != nil{
+		return fmt.Errorf("failed to record ingredient consumption: %v", err.Error())
+	}
+
+	return nil
+}
+
+func (r *inventoryRepository) GetRecipe(menuId string) ([]entity.RecipeItem, error){
+	items := []entity.RecipeItem{}
+
+	// Retrieve the ingredients of the menu item with their names and units
+	rows, err := r.db.Query(config.GetRecipeQuery, menuId)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve recipe: %v", err.Error())
+	}
+	defer rows.Close()
+
+	for rows.Next(){
+		var item entity.RecipeItem
+		if err := rows.Scan(&item.IngredientId, &item.IngredientName, &item.Unit, &item.Quantity); err != nil{
+			return nil, fmt.Errorf("failed to scan recipe: %v", err.Error())
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (r *inventoryRepository) SetRecipe(payload entity.Recipe) error{
+	// Replace the whole bill of materials of the menu item
+	if _, err := r.db.Exec(config.DeleteRecipeQuery, payload.MenuId); err != nil{
+		return fmt.Errorf("failed to clear recipe: %v", err.Error())
+	}
+
+	for _, item := range payload.Items{
+		if _, err := r.db.Exec(config.CreateRecipeItemQuery, payload.MenuId, item.IngredientId, item.Quantity); err != nil{
+			if pqErr, ok := err.(*pq.Error); ok {
+				if pqErr.Code == "23503" { // Foreign key violation
+					return fmt.Errorf("ingredient with id %s is not found", item.IngredientId)
+				}
+			}
+			return fmt.Errorf("failed to create recipe: %v", err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (r *inventoryRepository) GetLowStockIngredients() ([]entity.LowStockIngredient, error){
+	ingredients := []entity.LowStockIngredient{}
+
+	// Retrieve the ingredients at or below their reorder threshold, with the menu items using them
+	rows, err := r.db.Query(config.GetLowStockIngredientsQuery)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve low stock ingredients: %v", err.Error())
+	}
+	defer rows.Close()
+
+	for rows.Next(){
+		var ingredient entity.LowStockIngredient
+		if err := rows.Scan(&ingredient.Id, &ingredient.Name, &ingredient.Unit, &ingredient.QuantityOnHand, &ingredient.ReorderThreshold,
+			&ingredient.CreatedAt, &ingredient.UpdatedAt, pq.Array(&ingredient.AffectedMenus)); err != nil{
+			return nil, fmt.Errorf("failed to scan low stock ingredient: %v", err.Error())
+		}
+		ingredient.IsLow = true
+
+		ingredients = append(ingredients, ingredient)
+	}
+
+	return ingredients, nil
+}
+
+func NewInventoryRepository(db *sql.DB) InventoryRepository{
+	return &inventoryRepository{db: db}
+}
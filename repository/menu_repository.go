@@ -13,22 +13,31 @@ import (
 )
 
 type menuRepository struct{
-	db *sql.DB
+	db DBTX
 }
 
 type MenuRepository interface{
 	AddMenu(payload entity.Menu) (entity.MenuResponse, error)
-	GetAllMenu(page, size int, mtype, mname string) ([]entity.MenuResponse, model.Paging, error)
+	GetAllMenu(page, size int, categoryId, mname string) ([]entity.MenuResponse, model.Paging, error)
 	GetMenubyId(id string) (entity.MenuResponse, error)
 	UpdateMenu(payload entity.MenuResponse) (entity.MenuResponse, error)
-	DeleteMenu(id string) error
+	ArchiveMenu(id string, archivedAt time.Time) error
+	RestoreMenu(id string, updatedAt time.Time) error
+	UpdateMenuImage(id, imageKey string, updatedAt time.Time) error
+	GetArchivedMenus() ([]entity.MenuResponse, error)
 	GetMenubyName(name string) (entity.Menu, error)
+	GetMenusbyIds(ids []string) (map[string]entity.Menu, error)
+	UpdateMenuStock(payload entity.MenuStock, updatedAt time.Time) error
+	UpdateMenuAvailability(payload entity.MenuAvailability, updatedAt time.Time) error
+	ReserveStock(menuId string, quantity int) error
+	RestoreOrderStock(orderId string) error
+	ResetDailyStock() (int64, error)
 }
 
 func (r *menuRepository) AddMenu(payload entity.Menu) (entity.MenuResponse, error){
 	// Insert the value for menus.
-	err := r.db.QueryRow(config.CreateMenuQuery, payload.Name, payload.Type,
-		payload.Desc, payload.UnitType, payload.Price, payload.CreatedBy,
+	err := r.db.QueryRow(config.CreateMenuQuery, payload.Name, payload.CategoryId,
+		payload.Desc, payload.UnitTypeId, payload.Price, payload.PreparationTime, payload.CreatedBy,
 		payload.UpdatedAt).Scan(&payload.Id, &payload.CreatedAt, &payload.CreatedBy)
 	
 	if err != nil {
@@ -62,10 +71,11 @@ func (r *menuRepository) AddMenu(payload entity.Menu) (entity.MenuResponse, erro
 	response := entity.MenuResponse{
 		Id: payload.Id,
 		Name: payload.Name,
-		Type: payload.Type,
+		CategoryId: payload.CategoryId,
 		Desc: payload.Desc,
-		UnitType: payload.UnitType,
+		UnitTypeId: payload.UnitTypeId,
 		Price: payload.Price,
+		PreparationTime: payload.PreparationTime,
 		Rating: payload.Rating,
 		CreatedBy: payload.CreatedBy,
 		CreatedAt: formattedCreatedAt,
@@ -75,7 +85,7 @@ func (r *menuRepository) AddMenu(payload entity.Menu) (entity.MenuResponse, erro
 	return response, nil
 }
 
-func (r *menuRepository) GetAllMenu(page, size int, mtype, mname string) ([]entity.MenuResponse, model.Paging, error){
+func (r *menuRepository) GetAllMenu(page, size int, categoryId, mname string) ([]entity.MenuResponse, model.Paging, error){
 	var menus []entity.MenuResponse
 
 	// Calculate the offset for pagination based on the current page and page size.
@@ -84,13 +94,13 @@ func (r *menuRepository) GetAllMenu(page, size int, mtype, mname string) ([]enti
 	var rows *sql.Rows
 	var err error
 	
-	// Retrieve the Menus with pagination, otherwise include the filter by name and type
-	if mtype != "" && mname != ""{
-		rows, err = r.db.Query(config.GetAllMenuWithAllFilterQuery, size, offset, mtype, mname)
+	// Retrieve the Menus with pagination, otherwise include the filter by name and category
+	if categoryId != "" && mname != ""{
+		rows, err = r.db.Query(config.GetAllMenuWithAllFilterQuery, size, offset, categoryId, mname)
 	} else if mname != ""{
 		rows, err = r.db.Query(config.GetAllMenuWithFilterNameQuery, size, offset, mname)
-	} else if mtype != ""{
-		rows, err = r.db.Query(config.GetAllMenuWithFilterTypeQuery, size, offset, mtype)
+	} else if categoryId != ""{
+		rows, err = r.db.Query(config.GetAllMenuWithFilterCategoryQuery, size, offset, categoryId)
 	} else {
 		rows, err = r.db.Query(config.GetAllMenuQuery, size, offset)
 	}
@@ -104,16 +114,19 @@ func (r *menuRepository) GetAllMenu(page, size int, mtype, mname string) ([]enti
 	for rows.Next(){
 		var menu entity.MenuResponse
 		var createdAt, updateAt time.Time
+		var imageKey sql.NullString
 
 		// Scan menu data into struct fields, including timestamps for creation and update.
-		if err := rows.Scan(&menu.Id, &menu.Name, &menu.Type, &menu.Desc, &menu.UnitType,
-			&menu.Price, &menu.Rating, &menu.CreatedBy, &createdAt, &updateAt); err != nil{
+		if err := rows.Scan(&menu.Id, &menu.Name, &menu.CategoryId, &menu.Category, &menu.Desc, &menu.UnitTypeId, &menu.UnitType,
+			&menu.Price, &menu.PreparationTime, &menu.IsAvailable, &menu.DailyStock, &menu.StockLeft, &imageKey, &menu.Rating, &menu.CreatedBy,
+			&createdAt, &updateAt); err != nil{
 				 return nil, model.Paging{}, fmt.Errorf("failed to scan menu: %v", err.Error())
 			}
 
 		// Format the timestamps for the response in a readable format.
 		menu.CreatedAt = createdAt.Format("January 02, 2006 03:04 PM")
 		menu.UpdatedAt = updateAt.Format("January 02, 2006 03:04 PM")
+		menu.ImageKey = imageKey.String
 
 		// Append the menu object to the menus slice.
 		menus = append(menus, menu)
@@ -138,10 +151,13 @@ func (r *menuRepository) GetAllMenu(page, size int, mtype, mname string) ([]enti
 
 func (r *menuRepository) GetMenubyId(id string) (entity.MenuResponse, error){
 	var menu entity.MenuResponse
+	var archivedAt sql.NullTime
+	var imageKey sql.NullString
 
-	// Retrieve menu by id
+	// Retrieve menu by id, archived menus included
 	err := r.db.QueryRow(config.GetMenubyIdQuery, id).Scan(&menu.Id, &menu.Name,
-		&menu.Type, &menu.Desc, &menu.UnitType, &menu.Price, &menu.CreatedBy, &menu.CreatedAt, &menu.UpdatedAt)
+		&menu.CategoryId, &menu.Category, &menu.Desc, &menu.UnitTypeId, &menu.UnitType, &menu.Price, &menu.PreparationTime, &menu.IsAvailable, &menu.DailyStock, &menu.StockLeft,
+		&menu.CreatedBy, &menu.CreatedAt, &menu.UpdatedAt, &archivedAt, &imageKey)
 
 	// Handle potential errors from the query
 	if err != nil{
@@ -171,22 +187,30 @@ func (r *menuRepository) GetMenubyId(id string) (entity.MenuResponse, error){
 	response := entity.MenuResponse{
 		Id: menu.Id,
 		Name: menu.Name,
-		Type: menu.Type,
+		CategoryId: menu.CategoryId,
+		Category: menu.Category,
 		Desc: menu.Desc,
+		UnitTypeId: menu.UnitTypeId,
 		UnitType: menu.UnitType,
 		Price: menu.Price,
+		PreparationTime: menu.PreparationTime,
+		IsAvailable: menu.IsAvailable,
+		DailyStock: menu.DailyStock,
+		StockLeft: menu.StockLeft,
 		Rating: menu.Rating,
 		CreatedBy: menu.CreatedBy,
 		CreatedAt: formattedCreatedAt,
 		UpdatedAt: formattedUpdatedAt,
+		ArchivedAt: formatNullTime(archivedAt),
+		ImageKey: imageKey.String,
 	}
 
 	return response, nil
 }
 
 func (r *menuRepository) UpdateMenu(payload entity.MenuResponse) (entity.MenuResponse, error){
-	_, err := r.db.Exec(config.UpdateMenuQuery, payload.Id, payload.Name, payload.Type,
-		payload.Desc, payload.UnitType, payload.Price, payload.UpdatedAt)
+	_, err := r.db.Exec(config.UpdateMenuQuery, payload.Id, payload.Name, payload.CategoryId,
+		payload.Desc, payload.UnitTypeId, payload.Price, payload.PreparationTime, payload.UpdatedAt)
 	if err != nil{
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code {
@@ -213,20 +237,98 @@ func (r *menuRepository) UpdateMenu(payload entity.MenuResponse) (entity.MenuRes
 	return payload, nil
 }
 
-func (r *menuRepository) DeleteMenu(id string) error{
-	_, err := r.db.Exec(config.DeleteMenuQuery, id)
+func (r *menuRepository) ArchiveMenu(id string, archivedAt time.Time) error{
+	// Hide the menu instead of deleting it, so order history and reviews keep referencing it
+	result, err := r.db.Exec(config.ArchiveMenuQuery, id, archivedAt)
 	if err != nil{
-		return fmt.Errorf("failed to delete menu: %v", err.Error())
+		return fmt.Errorf("failed to archive menu: %v", err.Error())
+	}
+
+	// Ensure the menu wasn't archived already
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0{
+		return fmt.Errorf("menu with id %s is already archived", id)
+	}
+
+	return nil
+}
+
+func (r *menuRepository) RestoreMenu(id string, updatedAt time.Time) error{
+	result, err := r.db.Exec(config.RestoreMenuQuery, id, updatedAt)
+	if err != nil{
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23505" && pqErr.Constraint == "unique_menu_name" { // Unique violation
+				return fmt.Errorf("%w, another menu took the name of this menu while it was archived", config.ErrMenuConflict)
+			}
+			if pqErr.Code == "23505" && pqErr.Constraint == "unique_menu_description" {
+				return fmt.Errorf("%w, another menu took the description of this menu while it was archived", config.ErrMenuConflict)
+			}
+		}
+		return fmt.Errorf("failed to restore menu: %v", err.Error())
+	}
+
+	// Ensure the menu is archived
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0{
+		return fmt.Errorf("menu with id %s is not archived", id)
+	}
+
+	return nil
+}
+
+func (r *menuRepository) UpdateMenuImage(id, imageKey string, updatedAt time.Time) error{
+	// An empty key removes the image
+	result, err := r.db.Exec(config.UpdateMenuImageQuery, id, nullString(imageKey), updatedAt)
+	if err != nil{
+		return fmt.Errorf("failed to update menu image: %v", err.Error())
+	}
+
+	// Ensure the menu exists
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0{
+		return fmt.Errorf("menu with id %s is not found", id)
 	}
 
 	return nil
 }
 
+func (r *menuRepository) GetArchivedMenus() ([]entity.MenuResponse, error){
+	menus := []entity.MenuResponse{}
+
+	// Retrieve the archived menus, most recently archived first
+	rows, err := r.db.Query(config.GetArchivedMenusQuery)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve archived menu: %v", err.Error())
+	}
+	defer rows.Close()
+
+	// Iterate over the rows from the database, scanning each row into a menu object.
+	for rows.Next(){
+		var menu entity.MenuResponse
+		var createdAt, updateAt, archivedAt time.Time
+		var imageKey sql.NullString
+
+		if err := rows.Scan(&menu.Id, &menu.Name, &menu.CategoryId, &menu.Category, &menu.Desc, &menu.UnitTypeId, &menu.UnitType,
+			&menu.Price, &menu.PreparationTime, &menu.IsAvailable, &menu.DailyStock, &menu.StockLeft, &imageKey, &menu.Rating, &menu.CreatedBy,
+			&createdAt, &updateAt, &archivedAt); err != nil{
+			return nil, fmt.Errorf("failed to scan menu: %v", err.Error())
+		}
+
+		// Format the timestamps for the response in a readable format.
+		menu.CreatedAt = createdAt.Format("January 02, 2006 03:04 PM")
+		menu.UpdatedAt = updateAt.Format("January 02, 2006 03:04 PM")
+		menu.ArchivedAt = archivedAt.Format("January 02, 2006 03:04 PM")
+		menu.ImageKey = imageKey.String
+
+		menus = append(menus, menu)
+	}
+
+	return menus, nil
+}
+
 func (r *menuRepository) GetMenubyName(name string) (entity.Menu, error){
 	var menu entity.Menu
 
 	// Retrieve menu by name
-	err := r.db.QueryRow(config.GetMenubyNameQuery, name).Scan(&menu.Id, &menu.Name, &menu.Price)
+	err := r.db.QueryRow(config.GetMenubyNameQuery, name).Scan(&menu.Id, &menu.Name, &menu.Price, &menu.PreparationTime,
+		&menu.IsAvailable, &menu.StockLeft)
 
 	// Handle potential errors from the query
 	if err != nil{
@@ -241,6 +343,96 @@ func (r *menuRepository) GetMenubyName(name string) (entity.Menu, error){
 	return menu, nil
 }
 
+func (r *menuRepository) GetMenusbyIds(ids []string) (map[string]entity.Menu, error){
+	menus := make(map[string]entity.Menu)
+
+	// Retrieve the current name and price of every requested menu in one query
+	rows, err := r.db.Query(config.GetMenusbyIdsQuery, pq.Array(ids))
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve menu: %v", err.Error())
+	}
+	defer rows.Close()
+
+	// Index the menus by id; ids that no longer exist are simply absent from the map.
+	for rows.Next(){
+		var menu entity.Menu
+		if err := rows.Scan(&menu.Id, &menu.Name, &menu.Price, &menu.IsAvailable, &menu.StockLeft); err != nil{
+			return nil, fmt.Errorf("failed to scan menu: %v", err.Error())
+		}
+		menus[menu.Id] = menu
+	}
+
+	return menus, nil
+}
+
+func (r *menuRepository) UpdateMenuStock(payload entity.MenuStock, updatedAt time.Time) error{
+	result, err := r.db.Exec(config.UpdateMenuStockQuery, payload.MenuId, payload.DailyStock, payload.StockLeft, updatedAt)
+	if err != nil{
+		return fmt.Errorf("failed to update menu stock: %v", err.Error())
+	}
+
+	// Ensure the menu exists
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0{
+		return fmt.Errorf("menu with id %s is not found", payload.MenuId)
+	}
+
+	return nil
+}
+
+func (r *menuRepository) UpdateMenuAvailability(payload entity.MenuAvailability, updatedAt time.Time) error{
+	result, err := r.db.Exec(config.UpdateMenuAvailabilityQuery, payload.MenuId, *payload.IsAvailable, updatedAt)
+	if err != nil{
+		return fmt.Errorf("failed to update menu availability: %v", err.Error())
+	}
+
+	// Ensure the menu exists
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0{
+		return fmt.Errorf("menu with id %s is not found", payload.MenuId)
+	}
+
+	return nil
+}
+
+func (r *menuRepository) ReserveStock(menuId string, quantity int) error{
+	// Take the quantity off the stock in one statement, so concurrent orders can't oversell it
+	result, err := r.db.Exec(config.ReserveMenuStockQuery, menuId, quantity)
+	if err != nil{
+		return fmt.Errorf("failed to reserve menu stock: %v", err.Error())
+	}
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected > 0{
+		return nil
+	}
+
+	// Explain why the stock couldn't be reserved
+	var name string
+	var isAvailable bool
+	var stockLeft *int
+	if err := r.db.QueryRow(config.GetMenuStockQuery, menuId).Scan(&name, &isAvailable, &stockLeft); err != nil{
+		return fmt.Errorf("failed to retrieve menu stock: %v", err.Error())
+	}
+
+	return entity.StockError(name, isAvailable, stockLeft)
+}
+
+func (r *menuRepository) RestoreOrderStock(orderId string) error{
+	// Give the items of the order back to the stock, never above the daily stock
+	if _, err := r.db.Exec(config.RestoreOrderStockQuery, orderId); err != nil{
+		return fmt.Errorf("failed to restore menu stock: %v", err.Error())
+	}
+
+	return nil
+}
+
+func (r *menuRepository) ResetDailyStock() (int64, error){
+	result, err := r.db.Exec(config.ResetDailyStockQuery)
+	if err != nil{
+		return 0, fmt.Errorf("failed to reset daily stock: %v", err.Error())
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	return rowsAffected, nil
+}
+
 func NewMenuRepository(db *sql.DB) MenuRepository{
 	return &menuRepository{db: db}
 }
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+
+	"github.com/lib/pq"
+)
+
+type modifierRepository struct{
+	db DBTX
+}
+
+type ModifierRepository interface{
+	CreateModifierGroup(payload entity.ModifierGroup) (entity.ModifierGroup, error)
+	GetModifierGroupsByMenuIds(menuIds []string) (map[string][]entity.ModifierGroup, error)
+	DeleteModifierGroup(id string) error
+}
+
+func (r *modifierRepository) CreateModifierGroup(payload entity.ModifierGroup) (entity.ModifierGroup, error){
+	// Insert the value for menu_modifier_groups
+	if err := r.db.QueryRow(config.CreateModifierGroupQuery, payload.MenuId, payload.Name, payload.IsRequired,
+		payload.MinSelect, payload.MaxSelect).Scan(&payload.Id, &payload.CreatedAt); err != nil{
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23505" && pqErr.Constraint == "unique_modifier_group_name" { // Unique violation
+				return entity.ModifierGroup{}, fmt.Errorf("modifier group %s already exists for this menu", payload.Name)
+			}
+		}
+		return entity.ModifierGroup{}, fmt.Errorf("failed to create modifier group: %v", err.Error())
+	}
+
+	// Insert each option of the group
+	for i := range payload.Options{
+		payload.Options[i].GroupId = payload.Id
+		if err := r.db.QueryRow(config.CreateModifierOptionQuery, payload.Id, payload.Options[i].Name,
+			payload.Options[i].PriceDelta).Scan(&payload.Options[i].Id); err != nil{
+			return entity.ModifierGroup{}, fmt.Errorf("failed to create modifier option: %v", err.Error())
+		}
+	}
+
+	return payload, nil
+}
+
+func (r *modifierRepository) GetModifierGroupsByMenuIds(menuIds []string) (map[string][]entity.ModifierGroup, error){
+	groups := make(map[string][]entity.ModifierGroup)
+	if len(menuIds) == 0{
+		return groups, nil
+	}
+
+	// Retrieve the modifier groups and their options for every requested menu in one query
+	rows, err := r.db.Query(config.GetModifierGroupsByMenuIdsQuery, pq.Array(menuIds))
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve modifier groups: %v", err.Error())
+	}
+	defer rows.Close()
+
+	// Rows come ordered by group, so options are appended to the last group of the menu
+	for rows.Next(){
+		var group entity.ModifierGroup
+		var option entity.ModifierOption
+
+		if err := rows.Scan(&group.Id, &group.MenuId, &group.Name, &group.IsRequired, &group.MinSelect, &group.MaxSelect,
+			&option.Id, &option.Name, &option.PriceDelta); err != nil{
+			return nil, fmt.Errorf("failed to scan modifier group: %v", err.Error())
+		}
+		option.GroupId = group.Id
+
+		menuGroups := groups[group.MenuId]
+		if len(menuGroups) == 0 || menuGroups[len(menuGroups)-1].Id != group.Id{
+			menuGroups = append(menuGroups, group)
+		}
+		last := &menuGroups[len(menuGroups)-1]
+		last.Options = append(last.Options, option)
+		groups[group.MenuId] = menuGroups
+	}
+
+	return groups, nil
+}
+
+func (r *modifierRepository) DeleteModifierGroup(id string) error{
+	result, err := r.db.Exec(config.DeleteModifierGroupQuery, id)
+	if err != nil{
+		return fmt.Errorf("failed to delete modifier group: %v", err.Error())
+	}
+
+	// Ensure the modifier group exists
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0{
+		return fmt.Errorf("modifier group with id %s is not found", id)
+	}
+
+	return nil
+}
+
+func NewModifierRepository(db *sql.DB) ModifierRepository{
+	return &modifierRepository{db: db}
+}
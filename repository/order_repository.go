@@ -13,43 +13,41 @@ import (
 )
 
 type orderRepository struct{
-	db *sql.DB
+	db DBTX
 }
 
 type OrderRepository interface{
 	CreateOrder(payload entity.Order) (entity.OrderResponse, error)
 	CountUnfishOrder(customerId string, count *int) error
-	GetUnfinishOrderbyCustomerId(customerId string) (entity.OrderResponse, error)
+	CountUnfinishOrderByAddress(customerId, addressId string, count *int) error
+	GetUnfinishOrdersbyCustomerId(customerId string) ([]entity.OrderResponse, error)
 	GetOrderById(id string) (entity.OrderResponse, error)
 	UpdateOrderStatus(payload entity.OrderResponse) (entity.OrderResponse, error) 
 	CountfinishOrder(menuId, customerId, orderId string, count *int) error
 	GetCustomerId(id string) (entity.Order, error)
 	GetAllOrder(page, size int, status string) ([]entity.OrderResponse, model.Paging, error)
 	GetOrderHistory(page, size int, startDate, endDate string, customerId string) ([]entity.OrderResponse, model.Paging, error)
+	GetOrderForUpdate(id string) (entity.Order, error)
+	CancelOrder(id, reason string) error
+	CreateOrderStatusHistory(payload entity.OrderStatusHistory) (entity.OrderStatusHistory, error)
+	AssignCourier(id, courierId string) error
+	IsCourier(id string) (bool, error)
+	GetLeastLoadedCourier() (string, error)
+	GetCourierOrders(courierId string) ([]entity.OrderResponse, error)
+	GetDueScheduledOrders(releaseAt time.Time) ([]string, error)
+	GetOrderStockItems(orderId string) ([]entity.OrderItem, error)
+	AddOrderTip(payload entity.OrderTip) (entity.OrderTip, error)
+	GetTipReport(startDate, endDate time.Time, groupBy, timezone string) ([]entity.TipReportPeriod, error)
+	GetCustomerOrderForReorder(id, customerId string) (entity.Order, error)
+	GetKitchenQueue() ([]entity.KitchenQueueOrder, error)
+	MarkOrderItemDone(orderId, itemId string) error
 }
 
 func (r *orderRepository) CreateOrder(payload entity.Order) (entity.OrderResponse, error){
-	// Begin a new transaction.
-	tx, err := r.db.Begin()
-	if err != nil{
-		return entity.OrderResponse{}, fmt.Errorf("failed to begin transaction")
-	}
-
-	defer func ()  {
-		// Roll back on panic or error, otherwise commit the transaction.
-		if p := recover(); p != nil{
-			tx.Rollback()
-			panic(p)
-		} else if err != nil{
-			tx.Rollback()
-		} else {
-			err = tx.Commit()
-		}
-	}()
-
 	// Insert the value for order
-	if err := tx.QueryRow(config.CreateOrderQuery, payload.CustomerId, payload.Address, payload.PromoCode, payload.OrderStatus,
-		payload.Note, payload.Date, payload.TotalPrice).Scan(&payload.Id, &payload.CreatedAt); err != nil{
+	if err := r.db.QueryRow(config.CreateOrderQuery, payload.CustomerId, nullString(payload.AddressId), payload.Address, payload.PromoCode, payload.OrderStatus,
+		payload.Note, payload.Date, nullTime(payload.ScheduledAt.UTC()), payload.Subtotal, payload.DiscountAmount, payload.DeliveryDistance,
+		payload.DeliveryFee, payload.ServiceCharge, payload.TaxAmount, payload.TaxInclusive, payload.TotalPrice).Scan(&payload.Id, &payload.CreatedAt); err != nil{
 			return entity.OrderResponse{}, fmt.Errorf("failed to create order: %v", err.Error())
 		}
 	
@@ -65,31 +63,20 @@ func (r *orderRepository) CreateOrder(payload entity.Order) (entity.OrderRespons
 	for i := range payload.OrderItems{
 		payload.OrderItems[i].OrderId = payload.Id
 
-		// Retrieve the menu ID based on the provided menu name.
-		var menuId string
-		GetMenuNameQuery := "SELECT id FROM menus WHERE name = $1"
-		err := r.db.QueryRow(GetMenuNameQuery, payload.OrderItems[i].MenuName).Scan(&menuId)
-		if err != nil || menuId == ""{
-			return entity.OrderResponse{}, fmt.Errorf("menu with name %s is not found: %v", payload.OrderItems[i].MenuName, err.Error())
-		}
-		
-		payload.OrderItems[i].MenuName = menuId
-
-		// Insert the value for order_items 
-		if err := tx.QueryRow(config.CreateOrderItemQuery, payload.OrderItems[i].OrderId,
-			payload.OrderItems[i].MenuName, payload.OrderItems[i].Quantity).Scan(&payload.OrderItems[i].Id); err != nil{
+		// Insert the value for order_items, keeping the menu name and price as they were at checkout
+		if err := r.db.QueryRow(config.CreateOrderItemQuery, payload.OrderItems[i].OrderId, payload.OrderItems[i].MenuId,
+			payload.OrderItems[i].MenuName, payload.OrderItems[i].Quantity, payload.OrderItems[i].UnitPrice,
+			payload.OrderItems[i].LineTotal, payload.OrderItems[i].PreparationTime).Scan(&payload.OrderItems[i].Id); err != nil{
 				return entity.OrderResponse{}, fmt.Errorf("failed to create order items: %v", err.Error())
 			}
-	}
 
-	// Convert menu Ids back to names for the response
-	for i := range payload.OrderItems {
-		var menuName string
-		menuQuery := "SELECT name FROM menus WHERE id = $1"
-		if err := r.db.QueryRow(menuQuery, payload.OrderItems[i].MenuName).Scan(&menuName); err != nil {
-			return entity.OrderResponse{}, fmt.Errorf("failed to retrieve menu name: %v", err.Error())
+		// Insert the options chosen for the item
+		for _, option := range payload.OrderItems[i].Options{
+			if _, err := r.db.Exec(config.CreateOrderItemOptionQuery, payload.OrderItems[i].Id, option.OptionId,
+				option.GroupName, option.OptionName, option.PriceDelta); err != nil{
+				return entity.OrderResponse{}, fmt.Errorf("failed to create order item options: %v", err.Error())
+			}
 		}
-		payload.OrderItems[i].MenuName = menuName
 	}
 
 	// Format timestamps for the response in a readable format.
@@ -105,6 +92,13 @@ func (r *orderRepository) CreateOrder(payload entity.Order) (entity.OrderRespons
 		OrderStatus: payload.OrderStatus,
 		Note: payload.Note,
 		Date: formattedDate,
+		ScheduledAt: formatNullTime(nullTime(payload.ScheduledAt.UTC())),
+		Subtotal: payload.Subtotal,
+		DiscountAmount: payload.DiscountAmount,
+		DeliveryFee: payload.DeliveryFee,
+		ServiceCharge: payload.ServiceCharge,
+		TaxAmount: payload.TaxAmount,
+		TaxInclusive: payload.TaxInclusive,
 		TotalPrice: payload.TotalPrice,
 		CreatedAt: formattedCreatedAt,
 		OrderItems: payload.OrderItems,
@@ -121,72 +115,74 @@ func (r *orderRepository) CountUnfishOrder(customerId string, count *int) error{
 	return nil
 }
 
-func (r *orderRepository) GetUnfinishOrderbyCustomerId(customerId string) (entity.OrderResponse, error){
-	var order entity.OrderResponse
+func (r *orderRepository) CountUnfinishOrderByAddress(customerId, addressId string, count *int) error{
+	if err := r.db.QueryRow(config.CountUnfinishCustomerOrderByAddressQuery, customerId, addressId).Scan(count); err != nil{
+		return fmt.Errorf("failed to count unfinished order: %v", err)
+	}
 
-	// retrieve unfinish order by customerId
-	err := r.db.QueryRow(config.GetUnfinishOrderByCustomerIdQuery, customerId).Scan(&order.Id, &order.Address,
-		&order.PromoCode, &order.OrderStatus, &order.Note, &order.TotalPrice, &order.CreatedAt)
-		
-		// Handle potential errors from the query 
-		if err != nil{
-			// If no rows are found, return a specific "customer not has unfinis order" error message
-			if err == sql.ErrNoRows{
-				return entity.OrderResponse{}, fmt.Errorf("customer with id %s not has unfinish order: %v", customerId, err.Error())
-			}
-			// For other errors, return a general retrieval failure message
-			return entity.OrderResponse{}, fmt.Errorf("failed to retrieve order: %v", err.Error())
-		}
+	return nil
+}
 
-	// Retrieve order_items by order_id
-	rows, err := r.db.Query(config.GetOrderItemsByOrderIdQuery, order.Id)
+func (r *orderRepository) GetUnfinishOrdersbyCustomerId(customerId string) ([]entity.OrderResponse, error){
+	orders := []entity.OrderResponse{}
+
+	// retrieve unfinish orders by customerId
+	rows, err := r.db.Query(config.GetUnfinishOrderByCustomerIdQuery, customerId)
 	if err != nil{
-		return entity.OrderResponse{}, fmt.Errorf("failed to retrieve order items: %v", err.Error())
+		return nil, fmt.Errorf("failed to retrieve order: %v", err.Error())
 	}
 	defer rows.Close()
 
-	order.OrderItems = []entity.OrderItem{}
-
-	// Iterate over the rows from the database, scanning each row into a orderItem object.
+	// Iterate over the rows from the database, scanning each row into a order object.
 	for rows.Next(){
-		var orderItem entity.OrderItem
+		var order entity.OrderResponse
+		var scheduledAt sql.NullTime
+		var createdAt time.Time
+		var statusAge int
 
-		// Scan orderItem data into struct fields
-		if err := rows.Scan(&orderItem.Id, &orderItem.OrderId, &orderItem.MenuName, &orderItem.Quantity); err != nil {
-			return entity.OrderResponse{}, fmt.Errorf("failed to scan order item: %v", err.Error())
+		if err := rows.Scan(&order.Id, &order.Address, &order.PromoCode, &order.OrderStatus, &order.Note, &scheduledAt,
+			&order.Subtotal, &order.DiscountAmount, &order.DeliveryFee, &order.ServiceCharge, &order.TaxAmount, &order.TaxInclusive, &order.TotalPrice, &order.TipAmount, &createdAt,
+			&order.DeliveryDistance, &statusAge); err != nil{
+			return nil, fmt.Errorf("failed to scan order: %v", err.Error())
 		}
 
-		// Append the orderItem object to the orderItems slice.
-		order.OrderItems = append(order.OrderItems, orderItem)
-	}
+		// Keep how long the order has been in its current status, in seconds from the database
+		order.StatusAge = time.Duration(statusAge) * time.Second
 
-	// Parse and format the createdAt for the response in a readable format.
-	parsedTime, err := time.Parse(time.RFC3339, order.CreatedAt)
-	if err != nil {
-		return entity.OrderResponse{}, fmt.Errorf("error parsing time: %v", err.Error())
+		// Format the timestamps for the response in a readable format.
+		order.ScheduledAt = formatNullTime(scheduledAt)
+		order.CreatedAt = createdAt.Format("January 02, 2006 03:04 PM")
+
+		orders = append(orders, order)
 	}
-	formattedCreatedAt := parsedTime.Format("January 02, 2006 03:04 PM")
 
-	// Construct the response object with formatted data.
-	response := entity.OrderResponse{
-		Id: order.Id,
-		Address: order.Address,
-		PromoCode: order.PromoCode,
-		OrderStatus: order.OrderStatus,
-		Note:  order.Note,
-		TotalPrice: order.TotalPrice,
-		CreatedAt: formattedCreatedAt,
-		OrderItems: order.OrderItems,
+	// Close the rows before reading the items, the connection may be shared by a transaction
+	rows.Close()
+
+	// Retrieve order_items and the status timeline for each order
+	for i := range orders{
+		orderItems, err := r.getOrderItems(orders[i].Id)
+		if err != nil{
+			return nil, err
+		}
+		orders[i].OrderItems = orderItems
+
+		statusHistory, err := r.getOrderStatusHistory(orders[i].Id)
+		if err != nil{
+			return nil, err
+		}
+		orders[i].StatusHistory = statusHistory
 	}
 
-	return response, nil
+	return orders, nil
 }
 
 func (r *orderRepository) GetOrderById(id string) (entity.OrderResponse, error){
 	var order entity.OrderResponse
+	var scheduledAt sql.NullTime
 
-	err := r.db.QueryRow(config.GetOrderByIdQuery, id).Scan(&order.Id, &order.CustomerName, &order.Address,
-		&order.PromoCode, &order.OrderStatus, &order.Note, &order.TotalPrice, &order.CreatedAt)
+	err := r.db.QueryRow(config.GetOrderByIdQuery, id).Scan(&order.Id, &order.CustomerName, &order.CourierName, &order.Address,
+		&order.PromoCode, &order.OrderStatus, &order.Note, &scheduledAt, &order.Subtotal, &order.DiscountAmount, &order.DeliveryFee, &order.ServiceCharge, &order.TaxAmount, &order.TaxInclusive, &order.TotalPrice, &order.TipAmount, &order.CancelReason, &order.CreatedAt)
 	if err != nil{
 		if err == sql.ErrNoRows{
 			return entity.OrderResponse{}, fmt.Errorf("order with id %s is not found: %v", id, err.Error())
@@ -205,7 +201,8 @@ func (r *orderRepository) GetOrderById(id string) (entity.OrderResponse, error){
 		var orderItem entity.OrderItem
 
 		if err := rows.Scan(&orderItem.Id, &orderItem.OrderId,
-			&orderItem.MenuName, &orderItem.Quantity); err != nil{
+			&orderItem.MenuName, &orderItem.Quantity, &orderItem.UnitPrice, &orderItem.LineTotal,
+			&orderItem.PreparationTime, &orderItem.IsDone); err != nil{
 				return entity.OrderResponse{}, fmt.Errorf("faild to scan order items: %v", err.Error())
 		}
 
@@ -216,6 +213,10 @@ func (r *orderRepository) GetOrderById(id string) (entity.OrderResponse, error){
 		return entity.OrderResponse{}, fmt.Errorf("error occurred during row iteration: %v", err.Error())
 	}
 
+	if err := r.attachOrderItemOptions(order.Id, order.OrderItems); err != nil{
+		return entity.OrderResponse{}, err
+	}
+
 	parsedTime, err := time.Parse(time.RFC3339, order.CreatedAt)
 	if err != nil {
 		return entity.OrderResponse{}, fmt.Errorf("error parsing time: %v", err.Error())
@@ -223,16 +224,32 @@ func (r *orderRepository) GetOrderById(id string) (entity.OrderResponse, error){
 
 	formattedCreatedAt := parsedTime.Format("January 02, 2006 03:04 PM")
 
+	statusHistory, err := r.getOrderStatusHistory(order.Id)
+	if err != nil{
+		return entity.OrderResponse{}, err
+	}
+
 	response := entity.OrderResponse{
 		Id: order.Id,
 		CustomerName: order.CustomerName,
+		CourierName: order.CourierName,
 		Address: order.Address,
 		PromoCode: order.PromoCode,
 		OrderStatus: order.OrderStatus,
 		Note:  order.Note,
+		ScheduledAt: formatNullTime(scheduledAt),
+		Subtotal: order.Subtotal,
+		DiscountAmount: order.DiscountAmount,
+		DeliveryFee: order.DeliveryFee,
+		ServiceCharge: order.ServiceCharge,
+		TaxAmount: order.TaxAmount,
+		TaxInclusive: order.TaxInclusive,
+		TipAmount: order.TipAmount,
 		TotalPrice: order.TotalPrice,
+		CancelReason: order.CancelReason,
 		CreatedAt: formattedCreatedAt,
 		OrderItems: order.OrderItems,
+		StatusHistory: statusHistory,
 	}
 
 	return response, nil
@@ -282,11 +299,15 @@ func (r *orderRepository) GetAllOrder(page, size int, status string) ([]entity.O
 	
 	var statuses []string
 	if status == "unfinish"{
-		statuses = []string{"preparing", "out for delivery"}
+		statuses = entity.ActiveOrderStatuses
 	} else if status == "finish"{
 		statuses = []string{"delivered"}
+	} else if status == "cancelled"{
+		statuses = []string{"cancelled"}
+	} else if status == "scheduled"{
+		statuses = []string{"scheduled"}
 	} else if status == "all" {
-		statuses = []string{"preparing", "out for delivery", "delivered"}
+		statuses = append([]string{"scheduled", "delivered", "cancelled"}, entity.ActiveOrderStatuses...)
 	} else {
 		return nil, model.Paging{}, fmt.Errorf("status '%s' is not supported for filtering", status)
 	}
@@ -303,14 +324,17 @@ func (r *orderRepository) GetAllOrder(page, size int, status string) ([]entity.O
 	for rows.Next(){
 		var order entity.OrderResponse
 		var createdAt time.Time
+		var scheduledAt sql.NullTime
 
 		// Scan order data into struct fields, including timestamps for creation.
-		if err := rows.Scan(&order.Id, &order.CustomerName, &order.Address, &order.PromoCode, &order.OrderStatus, &order.Note, &order.TotalPrice, &createdAt); err != nil{
+		if err := rows.Scan(&order.Id, &order.CustomerName, &order.Address, &order.PromoCode, &order.OrderStatus, &order.Note,
+			&scheduledAt, &order.Subtotal, &order.DiscountAmount, &order.DeliveryFee, &order.ServiceCharge, &order.TaxAmount, &order.TaxInclusive, &order.TotalPrice, &order.TipAmount, &createdAt); err != nil{
 			return nil, model.Paging{}, fmt.Errorf("failed to scan order: %v", err.Error())
 		}
 
 		// Format the timestamps for the response in a readable format.
 		order.CreatedAt = createdAt.Format("January 02, 2006 03:04 PM")
+		order.ScheduledAt = formatNullTime(scheduledAt)
 
 		// Retrieve order_items by order_id
 		detailrows, err := r.db.Query(config.GetOrderItemsByOrderIdQuery, order.Id)
@@ -326,7 +350,8 @@ func (r *orderRepository) GetAllOrder(page, size int, status string) ([]entity.O
 
 			// Scan orderItem data into struct fields.
 			if err := detailrows.Scan(&orderItem.Id, &orderItem.OrderId,
-				&orderItem.MenuName, &orderItem.Quantity); err != nil{
+				&orderItem.MenuName, &orderItem.Quantity, &orderItem.UnitPrice, &orderItem.LineTotal,
+				&orderItem.PreparationTime, &orderItem.IsDone); err != nil{
 					return nil, model.Paging{}, fmt.Errorf("failed to scan order items: %v", err.Error())
 				}
 
@@ -334,6 +359,11 @@ func (r *orderRepository) GetAllOrder(page, size int, status string) ([]entity.O
 			orderItems = append(orderItems, orderItem)
 		}
 
+		// Attach the chosen options to each order item
+		if err := r.attachOrderItemOptions(order.Id, orderItems); err != nil{
+			return nil, model.Paging{}, err
+		}
+
 		// Assign orderItems into order.OrderItems
 		order.OrderItems = orderItems
 
@@ -384,7 +414,8 @@ func (r *orderRepository) GetOrderHistory(page, size int, startDate, endDate str
 		var createdAt, date time.Time
 
 		// Scan order data into struct fields, including timestamps for creation and date for filter purpose.
-		if err := rows.Scan(&order.Id, &order.Address, &order.PromoCode, &order.OrderStatus, &order.Note, &date, &order.TotalPrice, &createdAt); err != nil{
+		if err := rows.Scan(&order.Id, &order.Address, &order.PromoCode, &order.OrderStatus, &order.Note, &date,
+			&order.Subtotal, &order.DiscountAmount, &order.DeliveryFee, &order.ServiceCharge, &order.TaxAmount, &order.TaxInclusive, &order.TotalPrice, &order.TipAmount, &createdAt); err != nil{
 			return nil, model.Paging{}, fmt.Errorf("failed to scan order history: %v", err.Error())
 		}
 
@@ -406,7 +437,8 @@ func (r *orderRepository) GetOrderHistory(page, size int, startDate, endDate str
 
 			// Scan orderItem data into struct fields.
 			if err := detailRows.Scan(&orderItem.Id, &orderItem.OrderId,
-				&orderItem.MenuName, &orderItem.Quantity); err != nil{
+				&orderItem.MenuName, &orderItem.Quantity, &orderItem.UnitPrice, &orderItem.LineTotal,
+				&orderItem.PreparationTime, &orderItem.IsDone); err != nil{
 					return nil, model.Paging{}, fmt.Errorf("failed to scan order items: %v", err.Error())
 				}
 
@@ -414,6 +446,11 @@ func (r *orderRepository) GetOrderHistory(page, size int, startDate, endDate str
 			orderItems = append(orderItems, orderItem)
 		}
 
+		// Attach the chosen options to each order item
+		if err := r.attachOrderItemOptions(order.Id, orderItems); err != nil{
+			return nil, model.Paging{}, err
+		}
+
 		// Assign orderItems into order.OrderItems
 		order.OrderItems = orderItems
 
@@ -437,7 +474,414 @@ func (r *orderRepository) GetOrderHistory(page, size int, startDate, endDate str
 
 	return orders, paging, nil
 }
+func (r *orderRepository) GetOrderForUpdate(id string) (entity.Order, error){
+	var order entity.Order
+
+	// Retrieve the order by id and lock it until the surrounding transaction ends
+	err := r.db.QueryRow(config.GetOrderForUpdateQuery, id).Scan(&order.Id, &order.CustomerId, &order.CourierId, &order.Address,
+		&order.PromoCode, &order.OrderStatus, &order.Note, &order.TotalPrice, &order.TipAmount, &order.CreatedAt)
+
+	// Handle potential errors from the query
+	if err != nil{
+		// If no rows are found, return a specific "order not found" error message
+		if err == sql.ErrNoRows{
+			return entity.Order{}, fmt.Errorf("order with id %s is not found: %v", id, err.Error())
+		}
+		// For other errors, return a general retrieval failure message
+		return entity.Order{}, fmt.Errorf("failed to retrieve order: %v", err.Error())
+	}
+
+	return order, nil
+}
+
+func (r *orderRepository) CancelOrder(id, reason string) error{
+	// Set the order status to cancelled and give the promo usage back to the customer
+	_, err := r.db.Exec(config.CancelOrderQuery, id, reason)
+	if err != nil{
+		return fmt.Errorf("failed to cancel order: %v", err.Error())
+	}
+
+	return nil
+}
+
+func (r *orderRepository) CreateOrderStatusHistory(payload entity.OrderStatusHistory) (entity.OrderStatusHistory, error){
+	// Insert the value for order_status_history
+	if err := r.db.QueryRow(config.CreateOrderStatusHistoryQuery, payload.OrderId, nullString(payload.FromStatus),
+		payload.ToStatus, nullString(payload.ChangedBy), payload.Note).Scan(&payload.Id, &payload.CreatedAt); err != nil{
+		return entity.OrderStatusHistory{}, fmt.Errorf("failed to create order status history: %v", err.Error())
+	}
+
+	return payload, nil
+}
+
+func (r *orderRepository) AssignCourier(id, courierId string) error{
+	_, err := r.db.Exec(config.AssignCourierQuery, id, courierId)
+	if err != nil{
+		return fmt.Errorf("failed to assign courier: %v", err.Error())
+	}
+
+	return nil
+}
+
+func (r *orderRepository) IsCourier(id string) (bool, error){
+	var count int
+
+	if err := r.db.QueryRow(config.CountCourierQuery, id).Scan(&count); err != nil{
+		return false, fmt.Errorf("failed to check courier: %v", err.Error())
+	}
+
+	return count > 0, nil
+}
+
+func (r *orderRepository) GetLeastLoadedCourier() (string, error){
+	var courierId string
+
+	// Retrieve the courier with the fewest active deliveries
+	err := r.db.QueryRow(config.GetLeastLoadedCourierQuery).Scan(&courierId)
+	if err != nil{
+		// If no rows are found, return a specific "courier not found" error message
+		if err == sql.ErrNoRows{
+			return "", fmt.Errorf("no courier is available")
+		}
+		// For other errors, return a general retrieval failure message
+		return "", fmt.Errorf("failed to retrieve courier: %v", err.Error())
+	}
+
+	return courierId, nil
+}
+
+func (r *orderRepository) GetCourierOrders(courierId string) ([]entity.OrderResponse, error){
+	orders := []entity.OrderResponse{}
+
+	// Retrieve the active deliveries assigned to the courier
+	rows, err := r.db.Query(config.GetCourierOrderQuery, courierId)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve courier order: %v", err.Error())
+	}
+	defer rows.Close()
+
+	// Iterate over the rows from the database, scanning each row into a order object.
+	for rows.Next(){
+		var order entity.OrderResponse
+		var createdAt time.Time
+
+		if err := rows.Scan(&order.Id, &order.CustomerName, &order.Address, &order.OrderStatus,
+			&order.Note, &order.Subtotal, &order.DiscountAmount, &order.DeliveryFee, &order.ServiceCharge, &order.TaxAmount, &order.TaxInclusive, &order.TotalPrice, &order.TipAmount, &createdAt); err != nil{
+			return nil, fmt.Errorf("failed to scan courier order: %v", err.Error())
+		}
+
+		// Format the timestamps for the response in a readable format.
+		order.CreatedAt = createdAt.Format("January 02, 2006 03:04 PM")
+
+		orders = append(orders, order)
+	}
+
+	// Close the rows before reading the items, the connection may be shared by a transaction
+	rows.Close()
+
+	// Retrieve order_items for each order
+	for i := range orders{
+		orderItems, err := r.getOrderItems(orders[i].Id)
+		if err != nil{
+			return nil, err
+		}
+		orders[i].OrderItems = orderItems
+	}
+
+	return orders, nil
+}
+
+func (r *orderRepository) GetDueScheduledOrders(releaseAt time.Time) ([]string, error){
+	ids := []string{}
 
+	// Retrieve the scheduled orders that should be released to the kitchen by releaseAt
+	rows, err := r.db.Query(config.GetDueScheduledOrderQuery, releaseAt)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve scheduled order: %v", err.Error())
+	}
+	defer rows.Close()
+
+	for rows.Next(){
+		var id string
+		if err := rows.Scan(&id); err != nil{
+			return nil, fmt.Errorf("failed to scan scheduled order: %v", err.Error())
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (r *orderRepository) GetOrderStockItems(orderId string) ([]entity.OrderItem, error){
+	items := []entity.OrderItem{}
+
+	// Retrieve the total quantity ordered of every menu in the order
+	rows, err := r.db.Query(config.GetOrderStockItemsQuery, orderId)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve order items: %v", err.Error())
+	}
+	defer rows.Close()
+
+	for rows.Next(){
+		var item entity.OrderItem
+		if err := rows.Scan(&item.MenuId, &item.Quantity); err != nil{
+			return nil, fmt.Errorf("failed to scan order items: %v", err.Error())
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (r *orderRepository) AddOrderTip(payload entity.OrderTip) (entity.OrderTip, error){
+	// Insert the value for order_tips
+	if err := r.db.QueryRow(config.CreateOrderTipQuery, payload.OrderId, payload.CustomerId,
+		payload.Amount).Scan(&payload.Id, &payload.CreatedAt); err != nil{
+		return entity.OrderTip{}, fmt.Errorf("failed to create tip: %v", err.Error())
+	}
+
+	// Add the tip to the order's running tip total
+	if _, err := r.db.Exec(config.AddOrderTipQuery, payload.OrderId, payload.Amount); err != nil{
+		return entity.OrderTip{}, fmt.Errorf("failed to update order tip: %v", err.Error())
+	}
+
+	return payload, nil
+}
+
+func (r *orderRepository) GetTipReport(startDate, endDate time.Time, groupBy, timezone string) ([]entity.TipReportPeriod, error){
+	periods := []entity.TipReportPeriod{}
+
+	// Sum the tips of every period between startDate and endDate, the periods start at midnight in the given timezone
+	rows, err := r.db.Query(config.GetTipReportQuery, startDate, endDate, groupBy, timezone)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve tip report: %v", err.Error())
+	}
+	defer rows.Close()
+
+	for rows.Next(){
+		var period entity.TipReportPeriod
+		if err := rows.Scan(&period.Period, &period.TipCount, &period.TotalTips); err != nil{
+			return nil, fmt.Errorf("failed to scan tip report: %v", err.Error())
+		}
+		periods = append(periods, period)
+	}
+
+	return periods, nil
+}
+
+func (r *orderRepository) GetCustomerOrderForReorder(id, customerId string) (entity.Order, error){
+	var order entity.Order
+
+	// Retrieve the order, it must belong to the customer
+	err := r.db.QueryRow(config.GetCustomerOrderForReorderQuery, id, customerId).Scan(&order.Id, &order.CustomerId,
+		&order.AddressId, &order.OrderStatus, &order.Note)
+	if err != nil{
+		if err == sql.ErrNoRows{
+			return entity.Order{}, fmt.Errorf("order with id %s is not found", id)
+		}
+		return entity.Order{}, fmt.Errorf("failed to retrieve order: %v", err.Error())
+	}
+
+	// Retrieve the ordered items with their chosen options
+	order.OrderItems, err = r.getOrderItems(id)
+	if err != nil{
+		return entity.Order{}, err
+	}
+
+	// Retrieve the menu of every item, the item snapshot only keeps its name
+	rows, err := r.db.Query(config.GetOrderItemMenusByOrderIdQuery, id)
+	if err != nil{
+		return entity.Order{}, fmt.Errorf("failed to retrieve order items: %v", err.Error())
+	}
+	defer rows.Close()
+
+	menuIds := make(map[string]string)
+	for rows.Next(){
+		var orderItemId, menuId string
+		if err := rows.Scan(&orderItemId, &menuId); err != nil{
+			return entity.Order{}, fmt.Errorf("failed to scan order items: %v", err.Error())
+		}
+		menuIds[orderItemId] = menuId
+	}
+
+	// Set the menu and the chosen option ids on each item
+	for i := range order.OrderItems{
+		order.OrderItems[i].MenuId = menuIds[order.OrderItems[i].Id]
+		for _, option := range order.OrderItems[i].Options{
+			order.OrderItems[i].OptionIds = append(order.OrderItems[i].OptionIds, option.OptionId)
+		}
+	}
+
+	return order, nil
+}
+
+func (r *orderRepository) GetKitchenQueue() ([]entity.KitchenQueueOrder, error){
+	queue := []entity.KitchenQueueOrder{}
+
+	// Retrieve the orders waiting in the kitchen, first come first served
+	rows, err := r.db.Query(config.GetKitchenQueueQuery)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve kitchen queue: %v", err.Error())
+	}
+	defer rows.Close()
+
+	// Iterate over the rows from the database, scanning each row into a order object.
+	for rows.Next(){
+		var order entity.KitchenQueueOrder
+		var scheduledAt sql.NullTime
+		var createdAt time.Time
+
+		if err := rows.Scan(&order.Id, &order.CustomerName, &order.OrderStatus, &order.Note, &scheduledAt, &createdAt); err != nil{
+			return nil, fmt.Errorf("failed to scan order: %v", err.Error())
+		}
+
+		// Format the timestamps for the response in a readable format.
+		order.ScheduledAt = formatNullTime(scheduledAt)
+		order.CreatedAt = createdAt.Format("January 02, 2006 03:04 PM")
+
+		queue = append(queue, order)
+	}
+
+	// Close the rows before reading the items, the connection may be shared by a transaction
+	rows.Close()
+
+	// Retrieve order_items for each order
+	for i := range queue{
+		orderItems, err := r.getOrderItems(queue[i].Id)
+		if err != nil{
+			return nil, err
+		}
+		queue[i].OrderItems = orderItems
+	}
+
+	return queue, nil
+}
+
+func (r *orderRepository) MarkOrderItemDone(orderId, itemId string) error{
+	result, err := r.db.Exec(config.MarkOrderItemDoneQuery, orderId, itemId)
+	if err != nil{
+		return fmt.Errorf("failed to mark order item as done: %v", err.Error())
+	}
+
+	// Ensure the item belongs to an order in the kitchen queue
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0{
+		return fmt.Errorf("order item with id %s is not found in the kitchen queue", itemId)
+	}
+
+	return nil
+}
+
+func (r *orderRepository) getOrderItems(orderId string) ([]entity.OrderItem, error){
+	// Retrieve order_items by order_id
+	rows, err := r.db.Query(config.GetOrderItemsByOrderIdQuery, orderId)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve order items: %v", err.Error())
+	}
+	defer rows.Close()
+
+	orderItems := []entity.OrderItem{}
+
+	// Iterate over the rows from the database, scanning each row into a orderItem object.
+	for rows.Next(){
+		var orderItem entity.OrderItem
+
+		if err := rows.Scan(&orderItem.Id, &orderItem.OrderId, &orderItem.MenuName, &orderItem.Quantity, &orderItem.UnitPrice, &orderItem.LineTotal,
+			&orderItem.PreparationTime, &orderItem.IsDone); err != nil{
+			return nil, fmt.Errorf("failed to scan order items: %v", err.Error())
+		}
+
+		orderItems = append(orderItems, orderItem)
+	}
+
+	// Close the rows before reading the options, the connection may be shared by a transaction
+	rows.Close()
+
+	// Attach the chosen options to each order item
+	if err := r.attachOrderItemOptions(orderId, orderItems); err != nil{
+		return nil, err
+	}
+
+	return orderItems, nil
+}
+
+func (r *orderRepository) attachOrderItemOptions(orderId string, orderItems []entity.OrderItem) error{
+	// Retrieve the options of every item in the order
+	rows, err := r.db.Query(config.GetOrderItemOptionsByOrderIdQuery, orderId)
+	if err != nil{
+		return fmt.Errorf("failed to retrieve order item options: %v", err.Error())
+	}
+	defer rows.Close()
+
+	options := make(map[string][]entity.OrderItemOption)
+	for rows.Next(){
+		var orderItemId string
+		var option entity.OrderItemOption
+
+		if err := rows.Scan(&orderItemId, &option.OptionId, &option.GroupName, &option.OptionName, &option.PriceDelta); err != nil{
+			return fmt.Errorf("failed to scan order item option: %v", err.Error())
+		}
+
+		options[orderItemId] = append(options[orderItemId], option)
+	}
+
+	// Assign the options to their order item
+	for i := range orderItems{
+		orderItems[i].Options = options[orderItems[i].Id]
+		if orderItems[i].Options == nil{
+			orderItems[i].Options = []entity.OrderItemOption{}
+		}
+	}
+
+	return nil
+}
+
+func (r *orderRepository) getOrderStatusHistory(orderId string) ([]entity.OrderStatusHistoryResponse, error){
+	// Retrieve the status timeline by order_id
+	rows, err := r.db.Query(config.GetOrderStatusHistoryQuery, orderId)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve order status history: %v", err.Error())
+	}
+	defer rows.Close()
+
+	statusHistory := []entity.OrderStatusHistoryResponse{}
+
+	// Iterate over the rows from the database, scanning each row into a history object.
+	for rows.Next(){
+		var history entity.OrderStatusHistoryResponse
+		var createdAt time.Time
+
+		if err := rows.Scan(&history.FromStatus, &history.ToStatus, &history.ChangedBy, &history.Note, &createdAt); err != nil{
+			return nil, fmt.Errorf("failed to scan order status history: %v", err.Error())
+		}
+
+		// Format the timestamps for the response in a readable format.
+		history.CreatedAt = createdAt.Format("January 02, 2006 03:04 PM")
+
+		statusHistory = append(statusHistory, history)
+	}
+
+	return statusHistory, nil
+}
+
+// nullString stores an empty string as NULL, for optional uuid and enum columns.
+func nullString(value string) sql.NullString{
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+// nullTime stores a zero time as NULL, for optional timestamp columns.
+func nullTime(value time.Time) sql.NullTime{
+	return sql.NullTime{Time: value, Valid: !value.IsZero()}
+}
+
+// formatNullTime formats an optional timestamp in UTC for the response, or returns an empty string when it's NULL.
+// The zone is part of the text, since the client may have sent the time with any offset.
+func formatNullTime(value sql.NullTime) string{
+	if !value.Valid{
+		return ""
+	}
+
+	return value.Time.UTC().Format("January 02, 2006 03:04 PM MST")
+}
 
 func NewOrderRepository(db *sql.DB) OrderRepository{
 	return &orderRepository{db: db}
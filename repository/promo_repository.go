@@ -13,7 +13,7 @@ import (
 )
 
 type promoRepository struct{
-	db *sql.DB
+	db DBTX
 }
 
 type PromoRepository interface{
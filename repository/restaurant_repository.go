@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+
+	"github.com/lib/pq"
+)
+
+type restaurantRepository struct{
+	db *sql.DB
+}
+
+type RestaurantRepository interface{
+	GetSettings() (entity.RestaurantSettings, error)
+	UpdateOrderingPause(payload entity.RestaurantSettings) (entity.RestaurantSettings, error)
+	CreateOpeningHour(payload entity.OpeningHour) (entity.OpeningHour, error)
+	GetOpeningHours() ([]entity.OpeningHour, error)
+	DeleteOpeningHour(id string) error
+	CreateClosure(payload entity.RestaurantClosure) (entity.RestaurantClosure, error)
+	GetClosures(fromDate string) ([]entity.RestaurantClosure, error)
+	DeleteClosure(id string) error
+}
+
+func (r *restaurantRepository) GetSettings() (entity.RestaurantSettings, error){
+	var settings entity.RestaurantSettings
+
+	if err := r.db.QueryRow(config.GetRestaurantSettingsQuery).Scan(&settings.OrderingPaused, &settings.PauseReason,
+		&settings.UpdatedAt); err != nil{
+		return entity.RestaurantSettings{}, fmt.Errorf("failed to retrieve restaurant settings: %v", err.Error())
+	}
+
+	return settings, nil
+}
+
+func (r *restaurantRepository) UpdateOrderingPause(payload entity.RestaurantSettings) (entity.RestaurantSettings, error){
+	if err := r.db.QueryRow(config.UpdateOrderingPauseQuery, payload.OrderingPaused, nullString(payload.PauseReason),
+		nullString(payload.UpdatedBy)).Scan(&payload.UpdatedAt); err != nil{
+		return entity.RestaurantSettings{}, fmt.Errorf("failed to update ordering pause: %v", err.Error())
+	}
+
+	return payload, nil
+}
+
+func (r *restaurantRepository) CreateOpeningHour(payload entity.OpeningHour) (entity.OpeningHour, error){
+	// Insert the value for opening_hours
+	if err := r.db.QueryRow(config.CreateOpeningHourQuery, payload.DayOfWeek, payload.OpenTime,
+		payload.CloseTime).Scan(&payload.Id); err != nil{
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23505" && pqErr.Constraint == "unique_opening_hour" { // Unique violation
+				return entity.OpeningHour{}, fmt.Errorf("opening hours starting at %s already exist for that day", payload.OpenTime)
+			}
+		}
+		return entity.OpeningHour{}, fmt.Errorf("failed to create opening hours: %v", err.Error())
+	}
+
+	return payload, nil
+}
+
+func (r *restaurantRepository) GetOpeningHours() ([]entity.OpeningHour, error){
+	hours := []entity.OpeningHour{}
+
+	rows, err := r.db.Query(config.GetOpeningHoursQuery)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve opening hours: %v", err.Error())
+	}
+	defer rows.Close()
+
+	// Iterate over the rows from the database, scanning each row into a opening hour object.
+	for rows.Next(){
+		var hour entity.OpeningHour
+		if err := rows.Scan(&hour.Id, &hour.DayOfWeek, &hour.OpenTime, &hour.CloseTime); err != nil{
+			return nil, fmt.Errorf("failed to scan opening hours: %v", err.Error())
+		}
+		hours = append(hours, hour)
+	}
+
+	return hours, nil
+}
+
+func (r *restaurantRepository) DeleteOpeningHour(id string) error{
+	result, err := r.db.Exec(config.DeleteOpeningHourQuery, id)
+	if err != nil{
+		return fmt.Errorf("failed to delete opening hours: %v", err.Error())
+	}
+
+	// Ensure the opening hours exist
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0{
+		return fmt.Errorf("opening hours with id %s are not found", id)
+	}
+
+	return nil
+}
+
+func (r *restaurantRepository) CreateClosure(payload entity.RestaurantClosure) (entity.RestaurantClosure, error){
+	// Insert the value for restaurant_closures, closed days have no hours
+	if err := r.db.QueryRow(config.CreateClosureQuery, payload.Date, payload.IsClosed, nullString(payload.OpenTime),
+		nullString(payload.CloseTime), nullString(payload.Reason)).Scan(&payload.Id); err != nil{
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23505" && pqErr.Constraint == "unique_closure_date" { // Unique violation
+				return entity.RestaurantClosure{}, fmt.Errorf("a closure on %s already exists", payload.Date)
+			}
+		}
+		return entity.RestaurantClosure{}, fmt.Errorf("failed to create closure: %v", err.Error())
+	}
+
+	return payload, nil
+}
+
+func (r *restaurantRepository) GetClosures(fromDate string) ([]entity.RestaurantClosure, error){
+	closures := []entity.RestaurantClosure{}
+
+	// Retrieve the closures from the given date onwards
+	rows, err := r.db.Query(config.GetClosuresQuery, fromDate)
+	if err != nil{
+		return nil, fmt.Errorf("failed to retrieve closures: %v", err.Error())
+	}
+	defer rows.Close()
+
+	// Iterate over the rows from the database, scanning each row into a closure object.
+	for rows.Next(){
+		var closure entity.RestaurantClosure
+		if err := rows.Scan(&closure.Id, &closure.Date, &closure.IsClosed, &closure.OpenTime, &closure.CloseTime,
+			&closure.Reason); err != nil{
+			return nil, fmt.Errorf("failed to scan closure: %v", err.Error())
+		}
+		closures = append(closures, closure)
+	}
+
+	return closures, nil
+}
+
+func (r *restaurantRepository) DeleteClosure(id string) error{
+	result, err := r.db.Exec(config.DeleteClosureQuery, id)
+	if err != nil{
+		return fmt.Errorf("failed to delete closure: %v", err.Error())
+	}
+
+	// Ensure the closure exists
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0{
+		return fmt.Errorf("closure with id %s is not found", id)
+	}
+
+	return nil
+}
+
+func NewRestaurantRepository(db *sql.DB) RestaurantRepository{
+	return &restaurantRepository{db: db}
+}
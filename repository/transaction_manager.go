@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// DBTX is implemented by both *sql.DB and *sql.Tx, so a repository can run its queries
+// either directly on the connection pool or inside a shared transaction.
+type DBTX interface{
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// TxRepositories holds the repositories bound to a single transaction.
+type TxRepositories struct{
+	Order OrderRepository
+	Menu MenuRepository
+	Balance BalanceRepository
+	Promo PromoRepository
+	Modifier ModifierRepository
+	DeliveryZone DeliveryZoneRepository
+	Inventory InventoryRepository
+}
+
+type transactionManager struct{
+	db *sql.DB
+}
+
+type TransactionManager interface{
+	WithTransaction(fn func(repos TxRepositories) error) error
+}
+
+func (m *transactionManager) WithTransaction(fn func(repos TxRepositories) error) (err error){
+	// Begin a new transaction.
+	tx, err := m.db.Begin()
+	if err != nil{
+		return fmt.Errorf("failed to begin transaction: %v", err.Error())
+	}
+
+	defer func ()  {
+		// Roll back on panic or error, otherwise commit the transaction.
+		if p := recover(); p != nil{
+			tx.Rollback()
+			panic(p)
+		} else if err != nil{
+			tx.Rollback()
+		} else if commitErr := tx.Commit(); commitErr != nil{
+			err = fmt.Errorf("failed to commit transaction: %v", commitErr.Error())
+		}
+	}()
+
+	// Bind every repository to the same transaction so they commit or roll back as one unit.
+	repos := TxRepositories{
+		Order: &orderRepository{db: tx},
+		Menu: &menuRepository{db: tx},
+		Balance: &balanceRepository{db: tx},
+		Promo: &promoRepository{db: tx},
+		Modifier: &modifierRepository{db: tx},
+		DeliveryZone: &deliveryZoneRepository{db: tx},
+		Inventory: &inventoryRepository{db: tx},
+	}
+
+	err = fn(repos)
+	return err
+}
+
+func NewTransactionManager(db *sql.DB) TransactionManager{
+	return &transactionManager{db: db}
+}
@@ -195,7 +195,7 @@ func (r *userRepository) UpdateUser(payload entity.UserResponse) (entity.UserRes
 }
 
 func (r *userRepository) UpdateRole(payload entity.UserResponse) (entity.UserResponse, error){
-	_, err := r.db.Exec(config.AssignToEmployeeQuery, payload.Id, payload.Role, payload.UpdatedAt)
+	_, err := r.db.Exec(config.AssignRoleQuery, payload.Id, payload.Role, payload.UpdatedAt)
 	if err != nil{
 		return entity.UserResponse{}, fmt.Errorf("failed to update role: %v", err.Error())
 	}
@@ -206,6 +206,11 @@ func (r *userRepository) UpdateRole(payload entity.UserResponse) (entity.UserRes
 func (r *userRepository) DeleteUser(id string) error{
 	_, err := r.db.Exec(config.DeleteUserQuery, id)
 	if err != nil{
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23503" { // Foreign key violation
+				return fmt.Errorf("user created menus that have orders or reviews, so it can't be deleted")
+			}
+		}
 		return fmt.Errorf("failed to delete user: %v", err.Error())
 	}
 
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+)
+
+// TestConcurrentWalletUpdates fires parallel top-ups and debits at one wallet, each in its own transaction
+// that locks the wallet row first, and checks that the wallet matches the ledger afterwards. It needs a database
+// with assets/food_delivery_db.sql applied, for example
+// TEST_DATABASE_URL="host=localhost port=5432 user=postgres password=secret dbname=food_delivery_test sslmode=disable".
+func TestConcurrentWalletUpdates(t *testing.T){
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == ""{
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil{
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	// Seed a customer, the wallet row is created by the first lock
+	suffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	var customerId string
+	if err := db.QueryRow(`INSERT INTO users(username, email, password, role, gender) VALUES($1, $2, 'x', 'customer', 'male') RETURNING id`,
+		"wallet-"+suffix, "wallet-"+suffix+"@test.local").Scan(&customerId); err != nil{
+		t.Fatalf("failed to seed customer: %v", err)
+	}
+	defer db.Exec(`DELETE FROM users WHERE id = $1`, customerId)
+
+	txManager := NewTransactionManager(db)
+
+	// move credits or debits the wallet the way the balance and order use cases do
+	move := func(transactionType string, amount float64) error{
+		return txManager.WithTransaction(func(repos TxRepositories) error {
+			balance, err := repos.Balance.LockUserBalance(customerId)
+			if err != nil{
+				return err
+			}
+
+			payload := entity.Balance{CustomerId: customerId, TransactionType: transactionType, Amount: amount, Description: transactionType}
+			if transactionType == "credit"{
+				payload.Balance = balance + amount
+			} else {
+				if amount > balance{
+					return config.ErrInsufficientBalance
+				}
+				payload.Balance = balance - amount
+			}
+
+			if err := repos.Balance.UpdateUserBalance(customerId, payload.Balance); err != nil{
+				return err
+			}
+			_, err = repos.Balance.CreateBalance(payload)
+			return err
+		})
+	}
+
+	// Start with enough for a few debits, so some succeed and later ones race the top-ups
+	if err := move("credit", 30000); err != nil{
+		t.Fatalf("failed to top up: %v", err)
+	}
+
+	const workers = 20
+	var wg sync.WaitGroup
+	var debits int64
+	errs := make(chan error, workers*2)
+	for i := 0; i < workers; i++{
+		wg.Add(2)
+		go func(){
+			defer wg.Done()
+			err := move("debit", 10000)
+			if err == nil{
+				atomic.AddInt64(&debits, 1)
+			} else if !errors.Is(err, config.ErrInsufficientBalance){
+				errs <- fmt.Errorf("debit: %v", err)
+			}
+		}()
+		go func(){
+			defer wg.Done()
+			if err := move("credit", 5000); err != nil{
+				errs <- fmt.Errorf("top-up: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs{
+		t.Error(err)
+	}
+
+	// The wallet must equal the ledger and the successful moves, and no running balance may have gone below zero
+	var walletBalance, ledgerBalance, lowestBalance float64
+	if err := db.QueryRow(`SELECT balance FROM wallets WHERE customer_id = $1`, customerId).Scan(&walletBalance); err != nil{
+		t.Fatalf("failed to read wallet: %v", err)
+	}
+	if err := db.QueryRow(`SELECT COALESCE(SUM(CASE WHEN transaction_type = 'credit' THEN amount ELSE -amount END), 0),
+		COALESCE(MIN(balance), 0) FROM balances WHERE customer_id = $1`, customerId).Scan(&ledgerBalance, &lowestBalance); err != nil{
+		t.Fatalf("failed to read ledger: %v", err)
+	}
+	expected := 30000 + workers*5000 - float64(debits)*10000
+	if walletBalance != ledgerBalance || walletBalance != expected{
+		t.Errorf("wallet balance %.2f, ledger total %.2f and expected balance %.2f don't match", walletBalance, ledgerBalance, expected)
+	}
+	if walletBalance < 0 || lowestBalance < 0{
+		t.Errorf("balance went negative: wallet %.2f, lowest running balance %.2f", walletBalance, lowestBalance)
+	}
+}
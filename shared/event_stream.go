@@ -0,0 +1,76 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"food-delivery-apps/shared/service"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// HeartbeatInterval keeps idle connections open through proxies
+	HeartbeatInterval = 15 * time.Second
+	// RetryInterval tells EventSource clients how long to wait before reconnecting, in milliseconds
+	RetryInterval = 3000
+)
+
+// LastEventId reads the id of the last event an EventSource client received before reconnecting.
+func LastEventId(ctx *gin.Context) uint64{
+	lastEventId := ctx.GetHeader("Last-Event-ID")
+	if lastEventId == ""{
+		lastEventId = ctx.Query("lastEventId")
+	}
+
+	id, _ := strconv.ParseUint(lastEventId, 10, 64)
+	return id
+}
+
+func SendEventStream(ctx *gin.Context, events <-chan service.Event, missed []service.Event, unsubscribe func()){
+	defer unsubscribe()
+
+	// Set the Server-Sent Events headers and disable proxy buffering
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+
+	// Send the reconnect delay and replay the events missed since Last-Event-ID
+	fmt.Fprintf(ctx.Writer, "retry: %d\n\n", RetryInterval)
+	for _, event := range missed{
+		writeEvent(ctx, event)
+	}
+	ctx.Writer.Flush()
+
+	heartbeat := time.NewTicker(HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Request.Context().Done():
+			return
+		case event, ok := <-events:
+			if !ok{
+				return
+			}
+			writeEvent(ctx, event)
+			ctx.Writer.Flush()
+		case <-heartbeat.C:
+			fmt.Fprint(ctx.Writer, ": heartbeat\n\n")
+			ctx.Writer.Flush()
+		}
+	}
+}
+
+func writeEvent(ctx *gin.Context, event service.Event){
+	data, err := json.Marshal(event.Data)
+	if err != nil{
+		return
+	}
+
+	fmt.Fprintf(ctx.Writer, "id: %d\nevent: %s\ndata: %s\n\n", event.Id, event.Type, data)
+}
@@ -39,6 +39,18 @@ func SendPagedResponse(ctx *gin.Context, data []interface{}, paging model.Paging
 	})
 }
 
+func SendPagedStoreResponse(ctx *gin.Context, data []interface{}, paging model.Paging, storeStatus interface{}, message string) {
+	ctx.JSON(http.StatusOK, &model.PagedStoreResponse{
+		Status: model.Status{
+			Code: http.StatusOK,
+			Message: message,
+		},
+		Data: data,
+		Paging: paging,
+		StoreStatus: storeStatus,
+	})
+}
+
 func SendErrorResponse(ctx *gin.Context, code int, message string) {
 	ctx.AbortWithStatusJSON(code, &model.Status{
 		Code:    code,
@@ -0,0 +1,48 @@
+package model
+
+import "food-delivery-apps/entity"
+
+type AddressRequest struct{
+	Label string `json:"label"`
+	Address string `json:"address"`
+	Latitude float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type SingleAddressResponse struct{
+	Status Status `json:"status"`
+	Data entity.CustomerAddressResponse `json:"data"`
+}
+
+type ListAddressResponse struct{
+	Status Status `json:"status"`
+	Data []entity.CustomerAddressResponse `json:"data"`
+}
+
+type SingleDeliveryQuoteResponse struct{
+	Status Status `json:"status"`
+	Data entity.DeliveryQuote `json:"data"`
+}
+
+type DeliveryZoneRequest struct{
+	Name string `json:"name"`
+	ZoneType string `json:"zone_type"`
+	RadiusKm float64 `json:"radius_km"`
+	Polygon [][]float64 `json:"polygon"`
+	Fees []DeliveryZoneFeeRequest `json:"fees"`
+}
+
+type DeliveryZoneFeeRequest struct{
+	MaxDistanceKm float64 `json:"max_distance_km"`
+	Fee float64 `json:"fee"`
+}
+
+type SingleDeliveryZoneResponse struct{
+	Status Status `json:"status"`
+	Data entity.DeliveryZone `json:"data"`
+}
+
+type ListDeliveryZoneResponse struct{
+	Status Status `json:"status"`
+	Data []entity.DeliveryZone `json:"data"`
+}
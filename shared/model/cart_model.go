@@ -0,0 +1,28 @@
+package model
+
+import "food-delivery-apps/entity"
+
+type CartItemRequest struct{
+	MenuId string `json:"menu_id"`
+	Quantity int `json:"quantity"`
+	OptionIds []string `json:"option_ids"`
+}
+
+type UpdateCartItemRequest struct{
+	Quantity int `json:"quantity"`
+	OptionIds []string `json:"option_ids"`
+}
+
+type CheckoutCartRequest struct{
+	AddressId string `json:"address_id"`
+	PromoCode string `json:"promo_code"`
+	Note string `json:"note"`
+	ScheduledAt string `json:"scheduled_at"`
+	TipAmount float64 `json:"tip_amount"`
+	AcceptPriceChanges bool `json:"accept_price_changes"`
+}
+
+type SingleCartResponse struct{
+	Status Status `json:"status"`
+	Data entity.CartResponse `json:"data"`
+}
@@ -0,0 +1,30 @@
+package model
+
+import "food-delivery-apps/entity"
+
+type CategoryRequest struct{
+	Name string `json:"name"`
+	Desc string `json:"description"`
+	DisplayOrder int `json:"display_order"`
+	IsActive *bool `json:"is_active"`
+}
+
+type SingleMenuCategoryResponse struct{
+	Status Status `json:"status"`
+	Data entity.MenuCategory `json:"data"`
+}
+
+type ListMenuCategoryResponse struct{
+	Status Status `json:"status"`
+	Data []entity.MenuCategory `json:"data"`
+}
+
+type SingleUnitTypeResponse struct{
+	Status Status `json:"status"`
+	Data entity.UnitType `json:"data"`
+}
+
+type ListUnitTypeResponse struct{
+	Status Status `json:"status"`
+	Data []entity.UnitType `json:"data"`
+}
@@ -0,0 +1,56 @@
+package model
+
+import "food-delivery-apps/entity"
+
+type IngredientRequest struct{
+	Name string `json:"name"`
+	Unit string `json:"unit"`
+	QuantityOnHand float64 `json:"quantity_on_hand"`
+	ReorderThreshold float64 `json:"reorder_threshold"`
+}
+
+type UpdateIngredientRequest struct{
+	Name string `json:"name"`
+	Unit string `json:"unit"`
+	ReorderThreshold float64 `json:"reorder_threshold"`
+}
+
+type StockMovementRequest struct{
+	Quantity float64 `json:"quantity"`
+	Note string `json:"note"`
+}
+
+type RecipeItemRequest struct{
+	IngredientId string `json:"ingredient_id"`
+	Quantity float64 `json:"quantity"`
+}
+
+type RecipeRequest struct{
+	Items []RecipeItemRequest `json:"items"`
+}
+
+type SingleIngredientResponse struct{
+	Status Status `json:"status"`
+	Data entity.Ingredient `json:"data"`
+}
+
+type ListIngredientResponse struct{
+	Status Status `json:"status"`
+	Data []entity.Ingredient `json:"data"`
+}
+
+type ListLowStockIngredientResponse struct{
+	Status Status `json:"status"`
+	Data []entity.LowStockIngredient `json:"data"`
+}
+
+type PagedStockMovementResponse struct{
+	Status Status `json:"status"`
+	Data []entity.StockMovement `json:"data"`
+	Paging Paging `json:"paging"`
+}
+
+type SingleRecipeResponse struct{
+	Status Status `json:"status"`
+	Data entity.Recipe `json:"data"`
+}
@@ -16,6 +16,13 @@ type PagedResponse struct {
 	Paging Paging        `json:"paging"`
 }
 
+type PagedStoreResponse struct {
+	Status      Status        `json:"status"`
+	Data        []interface{} `json:"data"`
+	Paging      Paging        `json:"paging"`
+	StoreStatus interface{}   `json:"store_status"`
+}
+
 type Paging struct {
 	Page        int `json:"page"`
 	RowsPerPage int `json:"rowsPerPage"`
@@ -6,10 +6,11 @@ import (
 
 type MenuRequest struct{
 	Name string `json:"name"`
-	Type string `json:"type"`
+	CategoryId string `json:"category_id"`
 	Desc string `json:"description"`
-	UnitType string `json:"unit_type"`
+	UnitTypeId string `json:"unit_type_id"`
 	Price float64 `json:"price"`
+	PreparationTime int `json:"preparation_time"`
 }
 
 type SingleMenuResponse struct{
@@ -17,8 +18,40 @@ type SingleMenuResponse struct{
 	Data entity.MenuResponse `json:"data"`
 }
 
+type MenuStockRequest struct{
+	DailyStock *int `json:"daily_stock"`
+	StockLeft *int `json:"stock_left"`
+}
+
+type MenuAvailabilityRequest struct{
+	IsAvailable bool `json:"is_available"`
+}
+
+type ListMenuResponse struct{
+	Status Status `json:"status"`
+	Data []entity.MenuResponse `json:"data"`
+}
+
 type PagedMenuResponse struct{
 	Status Status `json:"status"`
 	Data entity.MenuResponse `json:"data"`
 	Paging Paging `json:"paging"`
-}
\ No newline at end of file
+	StoreStatus entity.StoreStatus `json:"store_status"`
+}
+type ModifierGroupRequest struct{
+	Name string `json:"name"`
+	IsRequired bool `json:"is_required"`
+	MinSelect int `json:"min_select"`
+	MaxSelect int `json:"max_select"`
+	Options []ModifierOptionRequest `json:"options"`
+}
+
+type ModifierOptionRequest struct{
+	Name string `json:"name"`
+	PriceDelta float64 `json:"price_delta"`
+}
+
+type SingleModifierGroupResponse struct{
+	Status Status `json:"status"`
+	Data entity.ModifierGroup `json:"data"`
+}
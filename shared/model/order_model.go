@@ -5,15 +5,54 @@ import (
 )
 
 type OrderRequest struct{
-	Address string `json:"address"`
+	AddressId string `json:"address_id"`
 	PromoCode string `json:"promo_code"`
 	Note string `json:"note"`
+	ScheduledAt string `json:"scheduled_at"`
+	TipAmount float64 `json:"tip_amount"`
 	OrderItems  []OrderItemRequest `json:"order_items"`
 }
 
 type OrderItemRequest struct{
 	MenuName string `json:"menu_name"`
 	Quantity int `json:"quantity"`
+	OptionIds []string `json:"option_ids"`
+}
+
+type UpdateOrderStatusRequest struct{
+	OrderStatus string `json:"order_status"`
+	Note string `json:"note"`
+}
+
+type SinglePriceQuoteResponse struct{
+	Status Status `json:"status"`
+	Data entity.PriceQuote `json:"data"`
+}
+
+type SingleReorderResponse struct{
+	Status Status `json:"status"`
+	Data entity.ReorderResponse `json:"data"`
+}
+
+type TipRequest struct{
+	Amount float64 `json:"amount"`
+}
+
+type SingleTipReportResponse struct{
+	Status Status `json:"status"`
+	Data entity.TipReport `json:"data"`
+}
+
+type CancelOrderRequest struct{
+	CancelReason string `json:"cancel_reason"`
+}
+
+type AssignCourierRequest struct{
+	CourierId string `json:"courier_id"`
+}
+
+type DeliveryNoteRequest struct{
+	Note string `json:"note"`
 }
 
 type SingleOrderResponse struct{
@@ -25,4 +64,24 @@ type PagedOrderResponse struct{
 	Status Status `json:"status"`
 	Data entity.OrderResponse `json:"data"`
 	Paging Paging `json:"paging"`
+}
+
+type OrderingPauseRequest struct{
+	OrderingPaused bool `json:"ordering_paused"`
+	PauseReason string `json:"pause_reason"`
+}
+
+type SingleKitchenCapacityResponse struct{
+	Status Status `json:"status"`
+	Data entity.KitchenCapacity `json:"data"`
+}
+
+type ListKitchenQueueResponse struct{
+	Status Status `json:"status"`
+	Data []entity.KitchenQueueOrder `json:"data"`
+}
+
+type ListOrderResponse struct{
+	Status Status `json:"status"`
+	Data []entity.OrderResponse `json:"data"`
 }
\ No newline at end of file
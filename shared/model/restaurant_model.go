@@ -0,0 +1,42 @@
+package model
+
+import "food-delivery-apps/entity"
+
+type OpeningHourRequest struct{
+	DayOfWeek int `json:"day_of_week"`
+	OpenTime string `json:"open_time"`
+	CloseTime string `json:"close_time"`
+}
+
+type SingleOpeningHourResponse struct{
+	Status Status `json:"status"`
+	Data entity.OpeningHour `json:"data"`
+}
+
+type ListOpeningHourResponse struct{
+	Status Status `json:"status"`
+	Data []entity.OpeningHour `json:"data"`
+}
+
+type ClosureRequest struct{
+	Date string `json:"date"`
+	IsClosed bool `json:"is_closed"`
+	OpenTime string `json:"open_time"`
+	CloseTime string `json:"close_time"`
+	Reason string `json:"reason"`
+}
+
+type SingleClosureResponse struct{
+	Status Status `json:"status"`
+	Data entity.RestaurantClosure `json:"data"`
+}
+
+type ListClosureResponse struct{
+	Status Status `json:"status"`
+	Data []entity.RestaurantClosure `json:"data"`
+}
+
+type SingleStoreStatusResponse struct{
+	Status Status `json:"status"`
+	Data entity.StoreStatus `json:"data"`
+}
@@ -11,6 +11,10 @@ type UserRequest struct{
 	Gender string `json:"gender"`
 }
 
+type RoleRequest struct{
+	Role string `json:"role"`
+}
+
 type LoginResponse struct{
 	Status Status `json:"status"`
 	Data dto.AuthResponse `json:"data"`
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"fmt"
+	"sync"
+)
+
+// KitchenTopic receives every order event, so employees can follow the kitchen queue.
+const KitchenTopic = "kitchen"
+
+type Event struct{
+	Id uint64
+	Topic string
+	Type string
+	Data interface{}
+}
+
+type eventHub struct{
+	mu sync.Mutex
+	lastId uint64
+	history []Event
+	historySize int
+	subscribers map[string]map[chan Event]struct{}
+}
+
+type EventHub interface{
+	Publish(topic, eventType string, data interface{})
+	Subscribe(topic string, lastEventId uint64) (<-chan Event, []Event, func())
+}
+
+func CustomerTopic(customerId string) string{
+	return fmt.Sprintf("customer:%s", customerId)
+}
+
+func (h *eventHub) Publish(topic, eventType string, data interface{}){
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// Give every event an increasing id, so reconnecting clients can resume after it
+	h.lastId++
+	event := Event{Id: h.lastId, Topic: topic, Type: eventType, Data: data}
+
+	// Keep the latest events in a ring buffer for Last-Event-ID replay
+	h.history = append(h.history, event)
+	if len(h.history) > h.historySize{
+		h.history = h.history[len(h.history)-h.historySize:]
+	}
+
+	// Deliver to every subscriber of the topic, skipping the ones that are too slow to keep up
+	for ch := range h.subscribers[topic]{
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+func (h *eventHub) Subscribe(topic string, lastEventId uint64) (<-chan Event, []Event, func()){
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// Collect the buffered events the client missed since its last event id.
+	// An id ahead of the hub means the server restarted, so everything buffered is replayed.
+	var missed []Event
+	if lastEventId > 0{
+		if lastEventId > h.lastId{
+			lastEventId = 0
+		}
+		for _, event := range h.history{
+			if event.Topic == topic && event.Id > lastEventId{
+				missed = append(missed, event)
+			}
+		}
+	}
+
+	// Register the subscriber channel for the topic
+	ch := make(chan Event, 16)
+	if h.subscribers[topic] == nil{
+		h.subscribers[topic] = make(map[chan Event]struct{})
+	}
+	h.subscribers[topic][ch] = struct{}{}
+
+	// Unsubscribe removes the channel from the topic and closes it
+	unsubscribe := func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		if _, ok := h.subscribers[topic][ch]; ok{
+			delete(h.subscribers[topic], ch)
+			if len(h.subscribers[topic]) == 0{
+				delete(h.subscribers, topic)
+			}
+			close(ch)
+		}
+	}
+
+	return ch, missed, unsubscribe
+}
+
+func NewEventHub(historySize int) EventHub{
+	return &eventHub{historySize: historySize, subscribers: make(map[string]map[chan Event]struct{})}
+}
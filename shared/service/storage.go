@@ -0,0 +1,71 @@
+package service
+
+import (
+	"fmt"
+	"food-delivery-apps/config"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+type localStorage struct{
+	dir string
+	publicUrl string
+}
+
+// Storage keeps uploaded files under a key such as menus/<id>/<name>.jpg.
+type Storage interface{
+	Save(key string, data []byte) error
+	Delete(key string) error
+	Url(key string) string
+}
+
+func (s *localStorage) Save(key string, data []byte) error{
+	fullPath, err := s.path(key)
+	if err != nil{
+		return err
+	}
+
+	// Create the folders of the key before writing the file
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil{
+		return fmt.Errorf("failed to create storage folder: %v", err.Error())
+	}
+	if err := os.WriteFile(fullPath, data, 0644); err != nil{
+		return fmt.Errorf("failed to save file: %v", err.Error())
+	}
+
+	return nil
+}
+
+func (s *localStorage) Delete(key string) error{
+	fullPath, err := s.path(key)
+	if err != nil{
+		return err
+	}
+
+	// A file that is already gone counts as deleted
+	if err := os.Remove(fullPath); err != nil && !os.IsNotExist(err){
+		return fmt.Errorf("failed to delete file: %v", err.Error())
+	}
+
+	return nil
+}
+
+func (s *localStorage) Url(key string) string{
+	return strings.TrimSuffix(s.publicUrl, "/") + "/" + key
+}
+
+// path resolves a key inside the storage folder, keys can't point outside of it
+func (s *localStorage) path(key string) (string, error){
+	cleaned := path.Clean("/" + key)
+	if key == "" || cleaned != "/"+key{
+		return "", fmt.Errorf("invalid storage key %s", key)
+	}
+
+	return filepath.Join(s.dir, filepath.FromSlash(cleaned)), nil
+}
+
+func NewLocalStorage(cfg config.StorageConfig) Storage{
+	return &localStorage{dir: cfg.Dir, publicUrl: cfg.PublicUrl}
+}
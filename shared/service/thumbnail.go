@@ -0,0 +1,62 @@
+package service
+
+import (
+	"image"
+	"image/draw"
+)
+
+// Thumbnail scales an image down to the given width, keeping its aspect ratio.
+// Every thumbnail pixel is the average of the source pixels it covers, so the result stays smooth.
+// Images that are already narrow enough are returned as they are.
+func Thumbnail(src image.Image, width int) image.Image{
+	bounds := src.Bounds()
+	if width <= 0 || bounds.Dx() <= width{
+		return src
+	}
+	height := bounds.Dy() * width / bounds.Dx()
+	if height < 1{
+		height = 1
+	}
+
+	// Work on premultiplied RGBA pixels, so transparent edges don't bleed into the average
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++{
+		// Rows of the source covered by this row of the thumbnail
+		y0 := y * bounds.Dy() / height
+		y1 := (y + 1) * bounds.Dy() / height
+		if y1 <= y0{
+			y1 = y0 + 1
+		}
+
+		for x := 0; x < width; x++{
+			x0 := x * bounds.Dx() / width
+			x1 := (x + 1) * bounds.Dx() / width
+			if x1 <= x0{
+				x1 = x0 + 1
+			}
+
+			var r, g, b, a, count uint64
+			for sy := y0; sy < y1; sy++{
+				row := rgba.Pix[sy*rgba.Stride:]
+				for sx := x0; sx < x1; sx++{
+					r += uint64(row[sx*4])
+					g += uint64(row[sx*4+1])
+					b += uint64(row[sx*4+2])
+					a += uint64(row[sx*4+3])
+					count++
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / count)
+			dst.Pix[i+1] = uint8(g / count)
+			dst.Pix[i+2] = uint8(b / count)
+			dst.Pix[i+3] = uint8(a / count)
+		}
+	}
+
+	return dst
+}
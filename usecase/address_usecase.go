@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"food-delivery-apps/entity"
+	"food-delivery-apps/repository"
+	"time"
+)
+
+type addressUseCase struct{
+	repo repository.AddressRepository
+}
+
+type AddressUseCase interface{
+	CreateAddress(payload entity.CustomerAddress) (entity.CustomerAddressResponse, error)
+	GetAddresses(customerId string) ([]entity.CustomerAddressResponse, error)
+	UpdateAddress(payload entity.CustomerAddress) (entity.CustomerAddressResponse, error)
+	DeleteAddress(id, customerId string) error
+}
+
+func (uc *addressUseCase) CreateAddress(payload entity.CustomerAddress) (entity.CustomerAddressResponse, error){
+	// Validate the fields provided in the payload
+	if err := payload.Validate(); err != nil{
+		return entity.CustomerAddressResponse{}, err
+	}
+
+	return uc.repo.CreateAddress(payload)
+}
+
+func (uc *addressUseCase) GetAddresses(customerId string) ([]entity.CustomerAddressResponse, error){
+	return uc.repo.GetAddresses(customerId)
+}
+
+func (uc *addressUseCase) UpdateAddress(payload entity.CustomerAddress) (entity.CustomerAddressResponse, error){
+	// Validate the fields provided in the payload
+	if err := payload.Validate(); err != nil{
+		return entity.CustomerAddressResponse{}, err
+	}
+
+	payload.UpdatedAt = time.Now()
+
+	return uc.repo.UpdateAddress(payload)
+}
+
+func (uc *addressUseCase) DeleteAddress(id, customerId string) error{
+	return uc.repo.DeleteAddress(id, customerId)
+}
+
+func NewAddressUseCase(repo repository.AddressRepository) AddressUseCase{
+	return &addressUseCase{repo: repo}
+}
@@ -9,6 +9,7 @@ import (
 
 type balanceUseCase struct{
 	repo repository.BalanceRepository
+	txManager repository.TransactionManager
 }
 
 type BalanceUseCase interface{
@@ -24,22 +25,37 @@ func (uc *balanceUseCase) IncreaseBalance(payload entity.Balance) (entity.Balanc
 		return entity.BalanceResponse{}, err
 	}
 
-	// Get customer's balance
-	balance, _ := uc.repo.GetUserBalance(payload.CustomerId)
-	if balance < 0 {
-		return entity.BalanceResponse{}, fmt.Errorf("failed to get balance")
+	// Lock the customer's wallet, add the amount and write the ledger entry in one transaction,
+	// so concurrent top-ups can't overwrite each other's running total.
+	var response entity.BalanceResponse
+	err := uc.txManager.WithTransaction(func(repos repository.TxRepositories) error {
+		// Get and lock customer's balance
+		balance, err := repos.Balance.LockUserBalance(payload.CustomerId)
+		if err != nil || balance < 0 {
+			return fmt.Errorf("failed to get balance")
+		}
+
+		// Adjust customer's balance by adding the amount.
+		payload.Balance = balance + payload.Amount
+		if err := repos.Balance.UpdateUserBalance(payload.CustomerId, payload.Balance); err != nil{
+			return err
+		}
+
+		// Insert the value from payload into balances
+		response, err = repos.Balance.CreateBalance(payload)
+		return err
+	})
+	if err != nil{
+		return entity.BalanceResponse{}, err
 	}
 
-	// Adjust customer's balance by adding the amount.
-	payload.Balance = balance + payload.Amount
-
-	return uc.repo.CreateBalance(payload)
+	return response, nil
 }
 
 func (uc *balanceUseCase) GetBalanceData(page, size int, customerId string) ([]entity.BalanceResponse, model.Paging, error){
 	return uc.repo.GetBalanceData(page, size, customerId)
 }
 
-func NewBalanceUseCase(repo repository.BalanceRepository) BalanceUseCase{
-	return &balanceUseCase{repo: repo}
+func NewBalanceUseCase(repo repository.BalanceRepository, txManager repository.TransactionManager) BalanceUseCase{
+	return &balanceUseCase{repo: repo, txManager: txManager}
 }
\ No newline at end of file
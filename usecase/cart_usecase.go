@@ -0,0 +1,277 @@
+package usecase
+
+import (
+	"fmt"
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+	"food-delivery-apps/repository"
+	"log"
+	"sort"
+	"strings"
+)
+
+type cartUseCase struct{
+	repo repository.CartRepository
+	menuRepo repository.MenuRepository
+	modifierRepo repository.ModifierRepository
+	orderUc OrderUseCase
+}
+
+type CartUseCase interface{
+	AddCartItem(payload entity.CartItem) (entity.CartResponse, error)
+	GetCart(customerId string) (entity.CartResponse, error)
+	UpdateCartItem(payload entity.CartItem) (entity.CartResponse, error)
+	DeleteCartItem(customerId, id string) (entity.CartResponse, error)
+	ClearCart(customerId string) error
+	Checkout(payload entity.CartCheckout) (entity.OrderResponse, error)
+}
+
+func (uc *cartUseCase) AddCartItem(payload entity.CartItem) (entity.CartResponse, error){
+	// Validate the fields provided in the payload
+	if err := payload.Validate(); err != nil{
+		return entity.CartResponse{}, err
+	}
+
+	// Retrieve the menu, so the cart keeps the name and price the customer saw
+	menu, err := uc.menuRepo.GetMenubyId(payload.MenuId)
+	if err != nil{
+		return entity.CartResponse{}, err
+	}
+
+	// Archived menus can't be ordered
+	if menu.ArchivedAt != ""{
+		return entity.CartResponse{}, fmt.Errorf("menu %s is no longer available", menu.Name)
+	}
+	if !menu.IsAvailable{
+		return entity.CartResponse{}, entity.StockError(menu.Name, menu.IsAvailable, menu.StockLeft)
+	}
+
+	// Price the item with the selected options, the same options in any order make the same line
+	payload.OptionIds = sortOptionIds(payload.OptionIds)
+	_, unitPrice, err := uc.priceCartItem(payload.MenuId, menu.Price, payload.OptionIds)
+	if err != nil{
+		return entity.CartResponse{}, err
+	}
+	payload.MenuName = menu.Name
+	payload.Price = unitPrice
+
+	if _, err := uc.repo.AddCartItem(payload); err != nil{
+		return entity.CartResponse{}, err
+	}
+
+	return uc.GetCart(payload.CustomerId)
+}
+
+func (uc *cartUseCase) GetCart(customerId string) (entity.CartResponse, error){
+	// Retrieve the items in the customer's cart
+	items, err := uc.repo.GetCartItems(customerId)
+	if err != nil{
+		return entity.CartResponse{}, err
+	}
+
+	// Retrieve the live name and price of every menu in the cart
+	menuIds := make([]string, 0, len(items))
+	for _, item := range items{
+		menuIds = append(menuIds, item.MenuId)
+	}
+	menus, err := uc.menuRepo.GetMenusbyIds(menuIds)
+	if err != nil{
+		return entity.CartResponse{}, err
+	}
+	groups, err := uc.modifierRepo.GetModifierGroupsByMenuIds(menuIds)
+	if err != nil{
+		return entity.CartResponse{}, err
+	}
+
+	// Price each line at the current menu price and flag deleted, repriced or sold out menus
+	cart := entity.CartResponse{Items: []entity.CartItemResponse{}}
+	for _, item := range items{
+		line := entity.CartItemResponse{
+			Id: item.Id,
+			MenuId: item.MenuId,
+			MenuName: item.MenuName,
+			Quantity: item.Quantity,
+			Options: []entity.OrderItemOption{},
+			AddedPrice: item.Price,
+			UpdatedAt: item.UpdatedAt.Format("January 02, 2006 03:04 PM"),
+		}
+
+		menu, ok := menus[item.MenuId]
+		if !ok{
+			// The menu was deleted, so this line can't be ordered anymore
+			line.IsDeleted = true
+			cart.HasChanges = true
+		} else if options, err := entity.SelectModifierOptions(groups[item.MenuId], item.OptionIds); err != nil{
+			// The menu's options changed, so the selection has to be made again
+			line.MenuName = menu.Name
+			line.InvalidOptions = err.Error()
+			cart.HasChanges = true
+		} else {
+			// The live unit price is the menu price plus the price of every chosen option
+			unitPrice := menu.Price
+			for _, option := range options{
+				unitPrice += option.PriceDelta
+			}
+
+			line.MenuName = menu.Name
+			line.Options = options
+			line.UnitPrice = unitPrice
+			line.LineTotal = unitPrice * float64(item.Quantity)
+			line.IsRepriced = unitPrice != item.Price
+			line.IsSoldOut = !menu.IsAvailable || (menu.StockLeft != nil && item.Quantity > *menu.StockLeft)
+			if line.IsRepriced{
+				cart.HasChanges = true
+			}
+			cart.TotalPrice += line.LineTotal
+		}
+
+		cart.Items = append(cart.Items, line)
+	}
+
+	return cart, nil
+}
+
+func (uc *cartUseCase) UpdateCartItem(payload entity.CartItem) (entity.CartResponse, error){
+	// Retrieve the cart line, it keeps the menu of the line
+	item, err := uc.repo.GetCartItem(payload.CustomerId, payload.Id)
+	if err != nil{
+		return entity.CartResponse{}, err
+	}
+	payload.MenuId = item.MenuId
+
+	// Validate the fields provided in the payload
+	if err := payload.Validate(); err != nil{
+		return entity.CartResponse{}, err
+	}
+
+	// Retrieve the menu, updating a line also accepts its current name and price
+	menu, err := uc.menuRepo.GetMenubyId(payload.MenuId)
+	if err != nil{
+		return entity.CartResponse{}, fmt.Errorf("menu is no longer available, remove it from the cart: %v", err.Error())
+	}
+	if menu.ArchivedAt != ""{
+		return entity.CartResponse{}, fmt.Errorf("menu %s is no longer available, remove it from the cart", menu.Name)
+	}
+
+	// Keep the current option selection when no new one is given
+	if payload.OptionIds == nil{
+		payload.OptionIds = item.OptionIds
+	}
+
+	// Price the item with the selected options
+	payload.OptionIds = sortOptionIds(payload.OptionIds)
+	_, unitPrice, err := uc.priceCartItem(payload.MenuId, menu.Price, payload.OptionIds)
+	if err != nil{
+		return entity.CartResponse{}, err
+	}
+	payload.MenuName = menu.Name
+	payload.Price = unitPrice
+
+	if err := uc.repo.UpdateCartItem(payload); err != nil{
+		return entity.CartResponse{}, err
+	}
+
+	return uc.GetCart(payload.CustomerId)
+}
+
+func (uc *cartUseCase) DeleteCartItem(customerId, id string) (entity.CartResponse, error){
+	if err := uc.repo.DeleteCartItem(customerId, id); err != nil{
+		return entity.CartResponse{}, err
+	}
+
+	return uc.GetCart(customerId)
+}
+
+func (uc *cartUseCase) ClearCart(customerId string) error{
+	return uc.repo.ClearCart(customerId)
+}
+
+func (uc *cartUseCase) Checkout(payload entity.CartCheckout) (entity.OrderResponse, error){
+	// Retrieve the cart priced at the current menu prices
+	cart, err := uc.GetCart(payload.CustomerId)
+	if err != nil{
+		return entity.OrderResponse{}, err
+	}
+	if len(cart.Items) == 0{
+		return entity.OrderResponse{}, config.ErrEmptyCart
+	}
+
+	// Collect the deleted and repriced lines that need the customer's attention
+	var deleted, repriced []string
+	for _, item := range cart.Items{
+		if item.IsDeleted || item.InvalidOptions != ""{
+			deleted = append(deleted, item.MenuName)
+		} else if item.IsRepriced{
+			repriced = append(repriced, item.MenuName)
+		}
+	}
+
+	// Deleted menus and outdated options can't be ordered, and repriced menus need to be accepted first
+	if len(deleted) > 0{
+		return entity.OrderResponse{}, fmt.Errorf("remove unavailable items from the cart: %s", strings.Join(deleted, ", "))
+	}
+	if len(repriced) > 0 && !payload.AcceptPriceChanges{
+		return entity.OrderResponse{}, fmt.Errorf("prices have changed for %s, review the cart and accept the price changes", strings.Join(repriced, ", "))
+	}
+
+	// Build the order from the cart lines
+	order := entity.Order{
+		CustomerId: payload.CustomerId,
+		AddressId: payload.AddressId,
+		PromoCode: payload.PromoCode,
+		Note: payload.Note,
+		ScheduledAt: payload.ScheduledAt,
+		TipAmount: payload.TipAmount,
+	}
+	for _, item := range cart.Items{
+		orderItem := entity.OrderItem{MenuName: item.MenuName, Quantity: item.Quantity}
+		for _, option := range item.Options{
+			orderItem.OptionIds = append(orderItem.OptionIds, option.OptionId)
+		}
+		order.OrderItems = append(order.OrderItems, orderItem)
+	}
+
+	// Place the order through the regular checkout flow
+	resp, err := uc.orderUc.CreateNewOrder(order)
+	if err != nil{
+		return entity.OrderResponse{}, err
+	}
+
+	// The order is already placed, so a failure to empty the cart is only logged
+	if err := uc.repo.ClearCart(payload.CustomerId); err != nil{
+		log.Printf("Error clearing cart after checkout: %v\n", err.Error())
+	}
+
+	return resp, nil
+}
+
+func (uc *cartUseCase) priceCartItem(menuId string, menuPrice float64, optionIds []string) ([]entity.OrderItemOption, float64, error){
+	// Check the selected options against the menu's modifier groups
+	groups, err := uc.modifierRepo.GetModifierGroupsByMenuIds([]string{menuId})
+	if err != nil{
+		return nil, 0, err
+	}
+	options, err := entity.SelectModifierOptions(groups[menuId], optionIds)
+	if err != nil{
+		return nil, 0, err
+	}
+
+	// The unit price is the menu price plus the price of every chosen option
+	unitPrice := menuPrice
+	for _, option := range options{
+		unitPrice += option.PriceDelta
+	}
+
+	return options, unitPrice, nil
+}
+
+// sortOptionIds returns a sorted copy of the chosen options, so a cart line doesn't depend on the order they were picked in.
+func sortOptionIds(optionIds []string) []string{
+	sorted := append([]string{}, optionIds...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func NewCartUseCase(repo repository.CartRepository, menuRepo repository.MenuRepository, modifierRepo repository.ModifierRepository, orderUc OrderUseCase) CartUseCase{
+	return &cartUseCase{repo: repo, menuRepo: menuRepo, modifierRepo: modifierRepo, orderUc: orderUc}
+}
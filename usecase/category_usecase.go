@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"fmt"
+	"food-delivery-apps/entity"
+	"food-delivery-apps/repository"
+	"time"
+)
+
+type categoryUseCase struct{
+	repo repository.CategoryRepository
+}
+
+type CategoryUseCase interface{
+	CreateMenuCategory(payload entity.MenuCategory) (entity.MenuCategory, error)
+	GetMenuCategories() ([]entity.MenuCategory, error)
+	GetActiveMenuCategories() ([]entity.MenuCategory, error)
+	UpdateMenuCategory(payload entity.MenuCategory) (entity.MenuCategory, error)
+	DeleteMenuCategory(id string) error
+	CreateUnitType(payload entity.UnitType) (entity.UnitType, error)
+	GetUnitTypes() ([]entity.UnitType, error)
+	UpdateUnitType(payload entity.UnitType) (entity.UnitType, error)
+	DeleteUnitType(id string) error
+}
+
+func (uc *categoryUseCase) CreateMenuCategory(payload entity.MenuCategory) (entity.MenuCategory, error){
+	// Validate the fields provided in the payload
+	if err := payload.Validate(); err != nil{
+		return entity.MenuCategory{}, err
+	}
+
+	payload.UpdatedAt = time.Now()
+
+	return uc.repo.CreateMenuCategory(payload)
+}
+
+func (uc *categoryUseCase) GetMenuCategories() ([]entity.MenuCategory, error){
+	return uc.repo.GetMenuCategories(false)
+}
+
+func (uc *categoryUseCase) GetActiveMenuCategories() ([]entity.MenuCategory, error){
+	return uc.repo.GetMenuCategories(true)
+}
+
+func (uc *categoryUseCase) UpdateMenuCategory(payload entity.MenuCategory) (entity.MenuCategory, error){
+	// Retrieve the current category by id
+	category, err := uc.repo.GetMenuCategoryById(payload.Id)
+	if err != nil{
+		return entity.MenuCategory{}, err
+	}
+
+	if payload.DisplayOrder < 0{
+		return entity.MenuCategory{}, fmt.Errorf("display order cannot be below zero")
+	}
+
+	// Check if fields are present before updating them
+	if payload.Name != ""{
+		category.Name = payload.Name
+	}
+	if payload.Desc != ""{
+		category.Desc = payload.Desc
+	}
+	if payload.DisplayOrder != 0{
+		category.DisplayOrder = payload.DisplayOrder
+	}
+	if payload.IsActive != nil{
+		category.IsActive = payload.IsActive
+	}
+	category.UpdatedAt = time.Now()
+
+	return uc.repo.UpdateMenuCategory(category)
+}
+
+func (uc *categoryUseCase) DeleteMenuCategory(id string) error{
+	return uc.repo.DeleteMenuCategory(id)
+}
+
+func (uc *categoryUseCase) CreateUnitType(payload entity.UnitType) (entity.UnitType, error){
+	// Validate the fields provided in the payload
+	if err := payload.Validate(); err != nil{
+		return entity.UnitType{}, err
+	}
+
+	payload.UpdatedAt = time.Now()
+
+	return uc.repo.CreateUnitType(payload)
+}
+
+func (uc *categoryUseCase) GetUnitTypes() ([]entity.UnitType, error){
+	return uc.repo.GetUnitTypes()
+}
+
+func (uc *categoryUseCase) UpdateUnitType(payload entity.UnitType) (entity.UnitType, error){
+	// Retrieve the current unit type by id
+	unitType, err := uc.repo.GetUnitTypeById(payload.Id)
+	if err != nil{
+		return entity.UnitType{}, err
+	}
+
+	if payload.DisplayOrder < 0{
+		return entity.UnitType{}, fmt.Errorf("display order cannot be below zero")
+	}
+
+	// Check if fields are present before updating them
+	if payload.Name != ""{
+		unitType.Name = payload.Name
+	}
+	if payload.Desc != ""{
+		unitType.Desc = payload.Desc
+	}
+	if payload.DisplayOrder != 0{
+		unitType.DisplayOrder = payload.DisplayOrder
+	}
+	if payload.IsActive != nil{
+		unitType.IsActive = payload.IsActive
+	}
+	unitType.UpdatedAt = time.Now()
+
+	return uc.repo.UpdateUnitType(unitType)
+}
+
+func (uc *categoryUseCase) DeleteUnitType(id string) error{
+	return uc.repo.DeleteUnitType(id)
+}
+
+func NewCategoryUseCase(repo repository.CategoryRepository) CategoryUseCase{
+	return &categoryUseCase{repo: repo}
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+	"food-delivery-apps/repository"
+	"math"
+)
+
+type deliveryUseCase struct{
+	repo repository.DeliveryZoneRepository
+	addressRepo repository.AddressRepository
+	txManager repository.TransactionManager
+	restaurantCfg config.RestaurantConfig
+}
+
+type DeliveryUseCase interface{
+	CreateDeliveryZone(payload entity.DeliveryZone) (entity.DeliveryZone, error)
+	GetAllDeliveryZones() ([]entity.DeliveryZone, error)
+	DeleteDeliveryZone(id string) error
+	GetDeliveryQuote(customerId, addressId string) (entity.DeliveryQuote, error)
+}
+
+func (uc *deliveryUseCase) CreateDeliveryZone(payload entity.DeliveryZone) (entity.DeliveryZone, error){
+	// Validate the fields provided in the payload
+	if err := payload.Validate(); err != nil{
+		return entity.DeliveryZone{}, err
+	}
+
+	// Insert the zone and its fee table in one transaction
+	var zone entity.DeliveryZone
+	err := uc.txManager.WithTransaction(func(repos repository.TxRepositories) error {
+		var err error
+		zone, err = repos.DeliveryZone.CreateDeliveryZone(payload)
+		return err
+	})
+	if err != nil{
+		return entity.DeliveryZone{}, err
+	}
+
+	return zone, nil
+}
+
+func (uc *deliveryUseCase) GetAllDeliveryZones() ([]entity.DeliveryZone, error){
+	return uc.repo.GetAllDeliveryZones()
+}
+
+func (uc *deliveryUseCase) DeleteDeliveryZone(id string) error{
+	return uc.repo.DeleteDeliveryZone(id)
+}
+
+func (uc *deliveryUseCase) GetDeliveryQuote(customerId, addressId string) (entity.DeliveryQuote, error){
+	// An order is delivered to one of the customer's saved addresses
+	if addressId == ""{
+		return entity.DeliveryQuote{}, config.ErrMissingFields
+	}
+	address, err := uc.addressRepo.GetAddressById(addressId, customerId)
+	if err != nil{
+		return entity.DeliveryQuote{}, err
+	}
+
+	// Measure the distance from the restaurant to the address
+	distance := entity.DistanceKm(uc.restaurantCfg.Latitude, uc.restaurantCfg.Longitude, address.Latitude, address.Longitude)
+
+	zones, err := uc.repo.GetAllDeliveryZones()
+	if err != nil{
+		return entity.DeliveryQuote{}, err
+	}
+
+	// Pick the cheapest fee among the zones covering the address
+	var quote *entity.DeliveryQuote
+	for _, zone := range zones{
+		if !zone.Contains(address.Latitude, address.Longitude, distance){
+			continue
+		}
+
+		fee := zone.FeeFor(distance)
+		if quote == nil || fee < quote.DeliveryFee{
+			quote = &entity.DeliveryQuote{
+				AddressId: address.Id,
+				Address: address.Address,
+				ZoneName: zone.Name,
+				DistanceKm: math.Round(distance*100) / 100,
+				DeliveryFee: fee,
+			}
+		}
+	}
+
+	// Reject addresses outside every delivery zone
+	if quote == nil{
+		return entity.DeliveryQuote{}, config.ErrOutsideDeliveryZone
+	}
+
+	return *quote, nil
+}
+
+func NewDeliveryUseCase(repo repository.DeliveryZoneRepository, addressRepo repository.AddressRepository, txManager repository.TransactionManager, restaurantCfg config.RestaurantConfig) DeliveryUseCase{
+	return &deliveryUseCase{repo: repo, addressRepo: addressRepo, txManager: txManager, restaurantCfg: restaurantCfg}
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+	"food-delivery-apps/repository"
+)
+
+type idempotencyUseCase struct{
+	repo repository.IdempotencyRepository
+}
+
+type IdempotencyUseCase interface{
+	BeginRequest(userId, key, requestHash string) (entity.IdempotencyKey, bool, error)
+	CompleteRequest(id string, statusCode int, body []byte) error
+	ReleaseRequest(id string) error
+	CleanUpExpiredKeys() (int64, error)
+}
+
+// BeginRequest claims the key for a new request, or returns the stored request to replay when the key was used before.
+func (uc *idempotencyUseCase) BeginRequest(userId, key, requestHash string) (entity.IdempotencyKey, bool, error){
+	// Claim the key, the unique constraint lets only one request through
+	record, created, err := uc.repo.CreateKey(entity.IdempotencyKey{UserId: userId, Key: key, RequestHash: requestHash})
+	if err != nil{
+		return entity.IdempotencyKey{}, false, err
+	}
+	if created{
+		return record, false, nil
+	}
+
+	// The key was used before, it can only be replayed for the same request once that one finished
+	record, err = uc.repo.GetKey(userId, key)
+	if err != nil{
+		return entity.IdempotencyKey{}, false, err
+	}
+	if record.RequestHash != requestHash{
+		return entity.IdempotencyKey{}, false, config.ErrIdempotencyKeyReused
+	}
+	if record.StatusCode == 0{
+		// A claim that never got a response is abandoned after a while, so the retry takes it over
+		createdAt, reclaimed, err := uc.repo.ReclaimKey(record.Id, config.IdempotencyClaimTimeout)
+		if err != nil{
+			return entity.IdempotencyKey{}, false, err
+		}
+		if !reclaimed{
+			return entity.IdempotencyKey{}, false, config.ErrIdempotencyKeyInProgress
+		}
+		record.CreatedAt = createdAt
+		return record, false, nil
+	}
+
+	return record, true, nil
+}
+
+func (uc *idempotencyUseCase) CompleteRequest(id string, statusCode int, body []byte) error{
+	return uc.repo.SaveResponse(id, statusCode, body)
+}
+
+func (uc *idempotencyUseCase) ReleaseRequest(id string) error{
+	return uc.repo.DeleteKey(id)
+}
+
+func (uc *idempotencyUseCase) CleanUpExpiredKeys() (int64, error){
+	return uc.repo.DeleteExpiredKeys(config.IdempotencyKeyTTL)
+}
+
+func NewIdempotencyUseCase(repo repository.IdempotencyRepository) IdempotencyUseCase{
+	return &idempotencyUseCase{repo: repo}
+}
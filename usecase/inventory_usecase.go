@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"fmt"
+	"food-delivery-apps/entity"
+	"food-delivery-apps/repository"
+	"food-delivery-apps/shared/model"
+	"time"
+)
+
+type inventoryUseCase struct{
+	repo repository.InventoryRepository
+	menuRepo repository.MenuRepository
+	txManager repository.TransactionManager
+}
+
+type InventoryUseCase interface{
+	CreateIngredient(payload entity.Ingredient, createdBy string) (entity.Ingredient, error)
+	GetIngredients() ([]entity.Ingredient, error)
+	UpdateIngredient(payload entity.Ingredient) (entity.Ingredient, error)
+	RecordStockMovement(payload entity.StockMovement) (entity.Ingredient, error)
+	GetStockMovements(ingredientId string, page, size int) ([]entity.StockMovement, model.Paging, error)
+	GetRecipe(menuId string) (entity.Recipe, error)
+	SetRecipe(payload entity.Recipe) (entity.Recipe, error)
+	GetLowStockIngredients() ([]entity.LowStockIngredient, error)
+}
+
+func (uc *inventoryUseCase) CreateIngredient(payload entity.Ingredient, createdBy string) (entity.Ingredient, error){
+	// Validate the fields provided in the payload
+	if err := payload.Validate(); err != nil{
+		return entity.Ingredient{}, err
+	}
+
+	payload.UpdatedAt = time.Now()
+
+	// Create the ingredient and record its opening stock in one transaction
+	var ingredient entity.Ingredient
+	err := uc.txManager.WithTransaction(func(repos repository.TxRepositories) error {
+		var err error
+		ingredient, err = repos.Inventory.CreateIngredient(payload)
+		if err != nil || payload.QuantityOnHand == 0{
+			return err
+		}
+
+		ingredient, err = repos.Inventory.AdjustQuantity(ingredient.Id, payload.QuantityOnHand, payload.UpdatedAt)
+		if err != nil{
+			return err
+		}
+		_, err = repos.Inventory.CreateStockMovement(entity.StockMovement{
+			IngredientId: ingredient.Id,
+			MovementType: "receipt",
+			Quantity: payload.QuantityOnHand,
+			Note: "opening stock",
+			CreatedBy: createdBy,
+		})
+		return err
+	})
+	if err != nil{
+		return entity.Ingredient{}, err
+	}
+	ingredient.SetLowFlag()
+
+	return ingredient, nil
+}
+
+func (uc *inventoryUseCase) GetIngredients() ([]entity.Ingredient, error){
+	ingredients, err := uc.repo.GetIngredients()
+	if err != nil{
+		return nil, err
+	}
+
+	// Flag the ingredients that need to be reordered
+	for i := range ingredients{
+		ingredients[i].SetLowFlag()
+	}
+
+	return ingredients, nil
+}
+
+func (uc *inventoryUseCase) UpdateIngredient(payload entity.Ingredient) (entity.Ingredient, error){
+	// Retrieve the current ingredient by id
+	ingredient, err := uc.repo.GetIngredientById(payload.Id)
+	if err != nil{
+		return entity.Ingredient{}, err
+	}
+
+	// The quantity on hand only changes through stock movements
+	if payload.QuantityOnHand != 0{
+		return entity.Ingredient{}, fmt.Errorf("use a receipt or an adjustment to change quantity_on_hand")
+	}
+	if payload.ReorderThreshold < 0{
+		return entity.Ingredient{}, fmt.Errorf("reorder_threshold cannot be below zero")
+	}
+
+	// Check if fields are present before updating them
+	if payload.Name != ""{
+		ingredient.Name = payload.Name
+	}
+	if payload.Unit != ""{
+		ingredient.Unit = payload.Unit
+	}
+	if payload.ReorderThreshold != 0{
+		ingredient.ReorderThreshold = payload.ReorderThreshold
+	}
+	ingredient.UpdatedAt = time.Now()
+
+	ingredient, err = uc.repo.UpdateIngredient(ingredient)
+	if err != nil{
+		return entity.Ingredient{}, err
+	}
+	ingredient.SetLowFlag()
+
+	return ingredient, nil
+}
+
+func (uc *inventoryUseCase) RecordStockMovement(payload entity.StockMovement) (entity.Ingredient, error){
+	// Validate the fields provided in the payload
+	if err := payload.Validate(); err != nil{
+		return entity.Ingredient{}, err
+	}
+
+	// Change the quantity on hand and record the movement in one transaction
+	var ingredient entity.Ingredient
+	err := uc.txManager.WithTransaction(func(repos repository.TxRepositories) error {
+		var err error
+		ingredient, err = repos.Inventory.AdjustQuantity(payload.IngredientId, payload.Quantity, time.Now())
+		if err != nil{
+			return err
+		}
+
+		// An adjustment can't count more out of stock than there is
+		if ingredient.QuantityOnHand < 0 && payload.Quantity < 0{
+			return fmt.Errorf("adjustment would leave %s below zero", ingredient.Name)
+		}
+
+		_, err = repos.Inventory.CreateStockMovement(payload)
+		return err
+	})
+	if err != nil{
+		return entity.Ingredient{}, err
+	}
+	ingredient.SetLowFlag()
+
+	return ingredient, nil
+}
+
+func (uc *inventoryUseCase) GetStockMovements(ingredientId string, page, size int) ([]entity.StockMovement, model.Paging, error){
+	// Ensure the ingredient exists
+	if _, err := uc.repo.GetIngredientById(ingredientId); err != nil{
+		return nil, model.Paging{}, err
+	}
+
+	return uc.repo.GetStockMovements(ingredientId, page, size)
+}
+
+func (uc *inventoryUseCase) GetRecipe(menuId string) (entity.Recipe, error){
+	// Ensure the menu exists
+	if _, err := uc.menuRepo.GetMenubyId(menuId); err != nil{
+		return entity.Recipe{}, err
+	}
+
+	items, err := uc.repo.GetRecipe(menuId)
+	if err != nil{
+		return entity.Recipe{}, err
+	}
+
+	return entity.Recipe{MenuId: menuId, Items: items}, nil
+}
+
+func (uc *inventoryUseCase) SetRecipe(payload entity.Recipe) (entity.Recipe, error){
+	// Ensure the menu exists
+	if _, err := uc.menuRepo.GetMenubyId(payload.MenuId); err != nil{
+		return entity.Recipe{}, err
+	}
+
+	// Validate the fields provided in the payload
+	if err := payload.Validate(); err != nil{
+		return entity.Recipe{}, err
+	}
+
+	// Replace the recipe in one transaction, so a bad ingredient leaves the old recipe in place
+	err := uc.txManager.WithTransaction(func(repos repository.TxRepositories) error {
+		return repos.Inventory.SetRecipe(payload)
+	})
+	if err != nil{
+		return entity.Recipe{}, err
+	}
+
+	return uc.GetRecipe(payload.MenuId)
+}
+
+func (uc *inventoryUseCase) GetLowStockIngredients() ([]entity.LowStockIngredient, error){
+	return uc.repo.GetLowStockIngredients()
+}
+
+func NewInventoryUseCase(repo repository.InventoryRepository, menuRepo repository.MenuRepository, txManager repository.TransactionManager) InventoryUseCase{
+	return &inventoryUseCase{repo: repo, menuRepo: menuRepo, txManager: txManager}
+}
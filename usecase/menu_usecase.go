@@ -1,21 +1,50 @@
 package usecase
 
 import (
+	"bytes"
+	"fmt"
+	"food-delivery-apps/config"
 	"food-delivery-apps/entity"
 	"food-delivery-apps/repository"
 	"food-delivery-apps/shared/model"
+	"food-delivery-apps/shared/service"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"log"
+	"path"
+	"strings"
 	"time"
+
+	"github.com/gabriel-vasile/mimetype"
 )
 
 type menuUseCase struct{
 	repo repository.MenuRepository
+	modifierRepo repository.ModifierRepository
+	categoryRepo repository.CategoryRepository
+	txManager repository.TransactionManager
+	storage service.Storage
+	menuCfg config.MenuConfig
+	storageCfg config.StorageConfig
 }
 
 type MenuUseCase interface{
 	CreateNewMenu(payload entity.Menu) (entity.MenuResponse, error)
-	GetAllMenu(page, size int, mtype, mname string) ([]entity.MenuResponse, model.Paging, error)
+	GetAllMenu(page, size int, category, mname string) ([]entity.MenuResponse, model.Paging, error)
 	UpdateMenu(payload entity.Menu) (entity.MenuResponse, error)
-	DeleteMenu(id string) error
+	ArchiveMenu(id string) error
+	RestoreMenu(id string) (entity.MenuResponse, error)
+	GetArchivedMenus() ([]entity.MenuResponse, error)
+	UploadMenuImage(menuId string, size int64, file io.Reader) (entity.MenuResponse, error)
+	DeleteMenuImage(menuId string) (entity.MenuResponse, error)
+	UpdateMenuStock(payload entity.MenuStock) (entity.MenuResponse, error)
+	SetMenuAvailability(payload entity.MenuAvailability) (entity.MenuResponse, error)
+	ResetDailyStock() (int64, error)
+	CreateModifierGroup(payload entity.ModifierGroup) (entity.ModifierGroup, error)
+	DeleteModifierGroup(id string) error
 }
 
 func (uc *menuUseCase) CreateNewMenu(payload entity.Menu) (entity.MenuResponse, error){
@@ -23,14 +52,59 @@ func (uc *menuUseCase) CreateNewMenu(payload entity.Menu) (entity.MenuResponse,
 	if err := payload.Validate(); err != nil{
 		return entity.MenuResponse{}, err
 	}
+
+	// The menu must be filed under an active category and unit type
+	category, unitType, err := uc.checkCategoryAndUnitType(payload.CategoryId, payload.UnitTypeId)
+	if err != nil{
+		return entity.MenuResponse{}, err
+	}
 	
 	payload.UpdatedAt = time.Now()
 
-	return uc.repo.AddMenu(payload)
+	menu, err := uc.repo.AddMenu(payload)
+	if err != nil{
+		return entity.MenuResponse{}, err
+	}
+	menu.Category = category.Name
+	menu.UnitType = unitType.Name
+
+	return menu, nil
 }
 
-func (uc *menuUseCase) GetAllMenu(page, size int, mtype, mname string) ([]entity.MenuResponse, model.Paging, error){
-	return uc.repo.GetAllMenu(page, size, mtype, mname)
+func (uc *menuUseCase) GetAllMenu(page, size int, category, mname string) ([]entity.MenuResponse, model.Paging, error){
+	// The category filter may be the id or the name of an active category
+	var categoryId string
+	if category != ""{
+		menuCategory, err := uc.categoryRepo.GetActiveMenuCategory(category)
+		if err != nil{
+			return nil, model.Paging{}, err
+		}
+		categoryId = menuCategory.Id
+	}
+
+	menus, paging, err := uc.repo.GetAllMenu(page, size, categoryId, mname)
+	if err != nil{
+		return nil, model.Paging{}, err
+	}
+
+	// Retrieve the modifier groups of the menus on this page
+	menuIds := make([]string, 0, len(menus))
+	for _, menu := range menus{
+		menuIds = append(menuIds, menu.Id)
+	}
+	groups, err := uc.modifierRepo.GetModifierGroupsByMenuIds(menuIds)
+	if err != nil{
+		return nil, model.Paging{}, err
+	}
+
+	// Flag the menu items that are sold out or running low
+	for i := range menus{
+		menus[i].ModifierGroups = groups[menus[i].Id]
+		menus[i].SetStockFlags(uc.menuCfg.LowStockThreshold)
+		uc.setImage(&menus[i])
+	}
+
+	return menus, paging, nil
 }
 
 func (uc *menuUseCase) UpdateMenu(payload entity.Menu) (entity.MenuResponse, error){
@@ -49,8 +123,11 @@ func (uc *menuUseCase) UpdateMenu(payload entity.Menu) (entity.MenuResponse, err
 	if payload.Name != ""{
 		menu.Name = payload.Name
 	}
-	if payload.Type != ""{
-		menu.Type = payload.Type
+	if payload.CategoryId != ""{
+		menu.CategoryId = payload.CategoryId
+	}
+	if payload.UnitTypeId != ""{
+		menu.UnitTypeId = payload.UnitTypeId
 	}
 	if payload.Desc != ""{
 		menu.Desc = payload.Desc
@@ -58,24 +135,304 @@ func (uc *menuUseCase) UpdateMenu(payload entity.Menu) (entity.MenuResponse, err
 	if payload.Price != 0{
 		menu.Price = payload.Price 
 	}
+	if payload.PreparationTime != 0{
+		menu.PreparationTime = payload.PreparationTime
+	}
+	
+	// A changed category or unit type must be active
+	if payload.CategoryId != "" || payload.UnitTypeId != ""{
+		category, unitType, err := uc.checkCategoryAndUnitType(menu.CategoryId, menu.UnitTypeId)
+		if err != nil{
+			return entity.MenuResponse{}, err
+		}
+		menu.Category = category.Name
+		menu.UnitType = unitType.Name
+	}
 	
 	menu.UpdatedAt = time.Now().Format("January 02, 2006 03:04 PM")
 
-	return uc.repo.UpdateMenu(menu)
+	menu, err = uc.repo.UpdateMenu(menu)
+	if err != nil{
+		return entity.MenuResponse{}, err
+	}
+	uc.setImage(&menu)
+
+	return menu, nil
 }
 
-func (uc *menuUseCase) DeleteMenu(id string) error{
+// checkCategoryAndUnitType retrieves the category and unit type of a menu and ensures both are active.
+func (uc *menuUseCase) checkCategoryAndUnitType(categoryId, unitTypeId string) (entity.MenuCategory, entity.UnitType, error){
+	category, err := uc.categoryRepo.GetMenuCategoryById(categoryId)
+	if err != nil{
+		return entity.MenuCategory{}, entity.UnitType{}, err
+	}
+	if !*category.IsActive{
+		return entity.MenuCategory{}, entity.UnitType{}, config.ErrInactiveMenuCategory
+	}
+
+	unitType, err := uc.categoryRepo.GetUnitTypeById(unitTypeId)
+	if err != nil{
+		return entity.MenuCategory{}, entity.UnitType{}, err
+	}
+	if !*unitType.IsActive{
+		return entity.MenuCategory{}, entity.UnitType{}, config.ErrInactiveUnitType
+	}
+
+	return category, unitType, nil
+}
+
+func (uc *menuUseCase) ArchiveMenu(id string) error{
 	// Retrieve the current menu by id
 	_, err := uc.repo.GetMenubyId(id)
 	if err != nil{
 		return err
 	}
 
-	return uc.repo.DeleteMenu(id)
+	return uc.repo.ArchiveMenu(id, time.Now())
+}
+
+func (uc *menuUseCase) RestoreMenu(id string) (entity.MenuResponse, error){
+	// Retrieve the current menu by id
+	if _, err := uc.repo.GetMenubyId(id); err != nil{
+		return entity.MenuResponse{}, err
+	}
+
+	if err := uc.repo.RestoreMenu(id, time.Now()); err != nil{
+		return entity.MenuResponse{}, err
+	}
+
+	return uc.getMenuWithStockFlags(id)
+}
+
+func (uc *menuUseCase) GetArchivedMenus() ([]entity.MenuResponse, error){
+	menus, err := uc.repo.GetArchivedMenus()
+	if err != nil{
+		return nil, err
+	}
+
+	for i := range menus{
+		uc.setImage(&menus[i])
+	}
+
+	return menus, nil
+}
+
+func (uc *menuUseCase) UploadMenuImage(menuId string, size int64, file io.Reader) (entity.MenuResponse, error){
+	// Retrieve the current menu by id
+	menu, err := uc.repo.GetMenubyId(menuId)
+	if err != nil{
+		return entity.MenuResponse{}, err
+	}
+
+	// Check the announced size first, then never read more than the limit allows
+	tooLarge := fmt.Errorf("%w, the limit is %d KB", config.ErrImageTooLarge, uc.storageCfg.MaxImageSize/1024)
+	if size > uc.storageCfg.MaxImageSize{
+		return entity.MenuResponse{}, tooLarge
+	}
+	data, err := io.ReadAll(io.LimitReader(file, uc.storageCfg.MaxImageSize+1))
+	if err != nil{
+		return entity.MenuResponse{}, fmt.Errorf("failed to read image: %v", err.Error())
+	}
+	if int64(len(data)) > uc.storageCfg.MaxImageSize{
+		return entity.MenuResponse{}, tooLarge
+	}
+
+	// Trust the file content rather than the name or the header sent by the client
+	mtype := mimetype.Detect(data)
+	if !mtype.Is("image/jpeg") && !mtype.Is("image/png") && !mtype.Is("image/gif"){
+		return entity.MenuResponse{}, config.ErrUnsupportedImageType
+	}
+
+	// Read only the header to check the dimensions before the whole image is decoded into memory
+	imgCfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil{
+		return entity.MenuResponse{}, fmt.Errorf("%w: %v", config.ErrUnsupportedImageType, err.Error())
+	}
+	if imgCfg.Width <= 0 || imgCfg.Height <= 0{
+		return entity.MenuResponse{}, fmt.Errorf("%w: the image has no pixels", config.ErrUnsupportedImageType)
+	}
+	if int64(imgCfg.Width) * int64(imgCfg.Height) > uc.storageCfg.MaxImagePixels{
+		return entity.MenuResponse{}, fmt.Errorf("%w, the limit is %d pixels and the image is %dx%d", config.ErrImageTooLarge, uc.storageCfg.MaxImagePixels, imgCfg.Width, imgCfg.Height)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil{
+		return entity.MenuResponse{}, fmt.Errorf("%w: %v", config.ErrUnsupportedImageType, err.Error())
+	}
+
+	// Every upload gets a new name, so cached copies of the previous image never go stale
+	imageKey := fmt.Sprintf("menus/%s/%d%s", menuId, time.Now().UnixNano(), mtype.Extension())
+	if err := uc.storage.Save(imageKey, data); err != nil{
+		return entity.MenuResponse{}, err
+	}
+	savedKeys := []string{imageKey}
+
+	// Make a thumbnail for every configured width, jpeg images stay jpeg and the others become png
+	for name, width := range config.MenuThumbnailWidths{
+		var encoded bytes.Buffer
+		thumbnail := service.Thumbnail(img, width)
+		if mtype.Is("image/jpeg"){
+			err = jpeg.Encode(&encoded, thumbnail, &jpeg.Options{Quality: 85})
+		} else {
+			err = png.Encode(&encoded, thumbnail)
+		}
+		if err == nil{
+			thumbnailKey := menuThumbnailKey(imageKey, name)
+			err = uc.storage.Save(thumbnailKey, encoded.Bytes())
+			savedKeys = append(savedKeys, thumbnailKey)
+		}
+		if err != nil{
+			uc.deleteFiles(savedKeys)
+			return entity.MenuResponse{}, fmt.Errorf("failed to create thumbnail: %v", err.Error())
+		}
+	}
+
+	// Point the menu at the new files, then remove the previous image
+	if err := uc.repo.UpdateMenuImage(menuId, imageKey, time.Now()); err != nil{
+		uc.deleteFiles(savedKeys)
+		return entity.MenuResponse{}, err
+	}
+	if menu.ImageKey != ""{
+		uc.deleteFiles(menuImageKeys(menu.ImageKey))
+	}
+
+	return uc.getMenuWithStockFlags(menuId)
+}
+
+func (uc *menuUseCase) DeleteMenuImage(menuId string) (entity.MenuResponse, error){
+	// Retrieve the current menu by id
+	menu, err := uc.repo.GetMenubyId(menuId)
+	if err != nil{
+		return entity.MenuResponse{}, err
+	}
+	if menu.ImageKey == ""{
+		return entity.MenuResponse{}, fmt.Errorf("menu with id %s has no image", menuId)
+	}
+
+	if err := uc.repo.UpdateMenuImage(menuId, "", time.Now()); err != nil{
+		return entity.MenuResponse{}, err
+	}
+	uc.deleteFiles(menuImageKeys(menu.ImageKey))
+
+	return uc.getMenuWithStockFlags(menuId)
+}
+
+// setImage fills in the URLs of the menu image and its thumbnails
+func (uc *menuUseCase) setImage(menu *entity.MenuResponse){
+	if menu.ImageKey == ""{
+		return
+	}
+
+	menu.Image = &entity.MenuImage{
+		Url: uc.storage.Url(menu.ImageKey),
+		Thumbnails: make(map[string]string, len(config.MenuThumbnailWidths)),
+	}
+	for name := range config.MenuThumbnailWidths{
+		menu.Image.Thumbnails[name] = uc.storage.Url(menuThumbnailKey(menu.ImageKey, name))
+	}
+}
+
+// deleteFiles removes stored files, a file left behind only costs disk space so failures are logged
+func (uc *menuUseCase) deleteFiles(keys []string){
+	for _, key := range keys{
+		if err := uc.storage.Delete(key); err != nil{
+			log.Printf("Error deleting menu image %s: %v\n", key, err.Error())
+		}
+	}
+}
+
+// menuThumbnailKey derives the key of a thumbnail from the key of the original image
+func menuThumbnailKey(imageKey, name string) string{
+	ext := path.Ext(imageKey)
+	thumbnailExt := ".png"
+	if ext == ".jpg"{
+		thumbnailExt = ".jpg"
+	}
+
+	return fmt.Sprintf("%s_%s%s", strings.TrimSuffix(imageKey, ext), name, thumbnailExt)
+}
+
+// menuImageKeys lists the original image and every thumbnail of it
+func menuImageKeys(imageKey string) []string{
+	keys := []string{imageKey}
+	for name := range config.MenuThumbnailWidths{
+		keys = append(keys, menuThumbnailKey(imageKey, name))
+	}
+
+	return keys
+}
+
+func (uc *menuUseCase) UpdateMenuStock(payload entity.MenuStock) (entity.MenuResponse, error){
+	// Validate the fields provided in the payload
+	if err := payload.Validate(); err != nil{
+		return entity.MenuResponse{}, err
+	}
+
+	if err := uc.repo.UpdateMenuStock(payload, time.Now()); err != nil{
+		return entity.MenuResponse{}, err
+	}
+
+	return uc.getMenuWithStockFlags(payload.MenuId)
+}
+
+func (uc *menuUseCase) SetMenuAvailability(payload entity.MenuAvailability) (entity.MenuResponse, error){
+	// Validate the fields provided in the payload
+	if err := payload.Validate(); err != nil{
+		return entity.MenuResponse{}, err
+	}
+
+	if err := uc.repo.UpdateMenuAvailability(payload, time.Now()); err != nil{
+		return entity.MenuResponse{}, err
+	}
+
+	return uc.getMenuWithStockFlags(payload.MenuId)
+}
+
+func (uc *menuUseCase) ResetDailyStock() (int64, error){
+	return uc.repo.ResetDailyStock()
+}
+
+func (uc *menuUseCase) getMenuWithStockFlags(id string) (entity.MenuResponse, error){
+	menu, err := uc.repo.GetMenubyId(id)
+	if err != nil{
+		return entity.MenuResponse{}, err
+	}
+	menu.SetStockFlags(uc.menuCfg.LowStockThreshold)
+	uc.setImage(&menu)
+
+	return menu, nil
+}
+
+func (uc *menuUseCase) CreateModifierGroup(payload entity.ModifierGroup) (entity.ModifierGroup, error){
+	// Retrieve the current menu by id
+	if _, err := uc.repo.GetMenubyId(payload.MenuId); err != nil{
+		return entity.ModifierGroup{}, err
+	}
+
+	// Validate the fields provided in the payload
+	if err := payload.Validate(); err != nil{
+		return entity.ModifierGroup{}, err
+	}
+
+	// Insert the group and its options in one transaction
+	var group entity.ModifierGroup
+	err := uc.txManager.WithTransaction(func(repos repository.TxRepositories) error {
+		var err error
+		group, err = repos.Modifier.CreateModifierGroup(payload)
+		return err
+	})
+	if err != nil{
+		return entity.ModifierGroup{}, err
+	}
+
+	return group, nil
+}
+
+func (uc *menuUseCase) DeleteModifierGroup(id string) error{
+	return uc.modifierRepo.DeleteModifierGroup(id)
 }
 
-func NewMenuUseCase(repo repository.MenuRepository) MenuUseCase{
-	return &menuUseCase{repo: repo}
+func NewMenuUseCase(repo repository.MenuRepository, modifierRepo repository.ModifierRepository, categoryRepo repository.CategoryRepository, txManager repository.TransactionManager, storage service.Storage, menuCfg config.MenuConfig, storageCfg config.StorageConfig) MenuUseCase{
+	return &menuUseCase{repo: repo, modifierRepo: modifierRepo, categoryRepo: categoryRepo, txManager: txManager, storage: storage, menuCfg: menuCfg, storageCfg: storageCfg}
 }
 
 // parseUpdateTime, err := time.Parse(time.RFC3339, menu.UpdatedAt)
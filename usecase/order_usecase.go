@@ -1,84 +1,96 @@
 package usecase
 
 import (
+	"errors"
 	"fmt"
+	"food-delivery-apps/config"
 	"food-delivery-apps/entity"
 	"food-delivery-apps/repository"
 	"food-delivery-apps/shared/model"
+	"food-delivery-apps/shared/service"
+	"sort"
 	"time"
 )
 
 type orderUseCase struct{
 	repo repository.OrderRepository
-	menuRepo repository.MenuRepository
-	balanceRepo repository.BalanceRepository
-	promoRepo repository.PromoRepository
+	restaurantUc RestaurantUseCase
+	pricingUc PricingUseCase
+	txManager repository.TransactionManager
+	eventHub service.EventHub
+	orderCfg config.OrderConfig
+	restaurantCfg config.RestaurantConfig
 }
 
 type OrderUseCase interface{
 	CreateNewOrder(payload entity.Order) (entity.OrderResponse, error)
-	GetUnfinishCustomerOrder(customerId string) (entity.OrderResponse, error)
-	UpdateOrderStatus(payload entity.OrderResponse) (entity.OrderResponse, error)
+	QuoteOrder(payload entity.Order) (entity.PriceQuote, error)
+	GetUnfinishCustomerOrders(customerId string) ([]entity.OrderResponse, error)
+	GetKitchenQueue() ([]entity.KitchenQueueOrder, error)
+	MarkOrderItemDone(orderId, itemId string) (entity.OrderResponse, error)
+	GetKitchenCapacity() (entity.KitchenCapacity, error)
+	SetOrderingPause(payload entity.RestaurantSettings) (entity.KitchenCapacity, error)
+	UpdateOrderStatus(payload entity.OrderStatusHistory) (entity.OrderResponse, error)
 	GetAllOrder(page, size int, status string) ([]entity.OrderResponse, model.Paging, error) 
 	GetOrderHistory(page, size int, startDate, endDate string, customerId string) ([]entity.OrderResponse, model.Paging, error)
+	CancelCustomerOrder(id, customerId string) (entity.OrderResponse, error)
+	TipOrder(id, customerId string, amount float64) (entity.OrderResponse, error)
+	Reorder(id, customerId string) (entity.ReorderResponse, error)
+	GetTipReport(startDate, endDate, groupBy string) (entity.TipReport, error)
+	CancelOrderByEmployee(id, employeeId, reason string) (entity.OrderResponse, error)
+	AssignCourier(id, courierId, employeeId string) (entity.OrderResponse, error)
+	GetCourierOrders(courierId string) ([]entity.OrderResponse, error)
+	UpdateDeliveryStatus(payload entity.OrderStatusHistory) (entity.OrderResponse, error)
+	ReleaseScheduledOrders() (int, error)
+	SubscribeCustomerOrders(customerId string, lastEventId uint64) (<-chan service.Event, []service.Event, func())
+	SubscribeKitchenOrders(lastEventId uint64) (<-chan service.Event, []service.Event, func())
 }
 
 func (uc *orderUseCase) CreateNewOrder(payload entity.Order) (entity.OrderResponse, error){
-	// Get totalPrice from CalculateTotalPrice method
-	totalPrice, err := uc.CalculateTotalPrice(payload)
+	// Retrieve the opening hours and closures in the restaurant's timezone
+	schedule, err := uc.restaurantUc.GetSchedule()
 	if err != nil{
 		return entity.OrderResponse{}, err
 	}
 
-	// Check for any undelivered orders by the customer; prevent new orders if one exists.
-	var count int
-	err = uc.repo.CountUnfishOrder(payload.CustomerId, &count)
-	if err != nil{
-		return entity.OrderResponse{}, err
-	}
-	if count > 0{
-		return entity.OrderResponse{}, fmt.Errorf("cannot place a new order until the current one is delivered")
-	}
-
-	// Apply discount if a promo code is provided.
-	if payload.PromoCode != ""{
-		promo, err := uc.ApplyPromo(payload)
-		if err != nil{
+	// Validate the requested delivery time of a scheduled order, other orders need the restaurant to be open now
+	isScheduled := !payload.ScheduledAt.IsZero()
+	if isScheduled{
+		if err := uc.validateScheduledAt(schedule, payload.ScheduledAt); err != nil{
 			return entity.OrderResponse{}, err
 		}
-
-		// Adjust total price based on promo type (percentage or flat).
-		if promo.IsPercentage {
-			discount := (totalPrice * promo.Discount) / 100
-			totalPrice -= discount
-		} else {
-			totalPrice -= promo.Discount
+	} else if isOpen, _ := schedule.IsOpenAt(time.Now()); !isOpen{
+		if nextOpening, ok := schedule.NextOpening(time.Now()); ok{
+			return entity.OrderResponse{}, fmt.Errorf("%w, it opens again at %s", config.ErrRestaurantClosed, nextOpening.Format(time.RFC3339))
 		}
+		return entity.OrderResponse{}, config.ErrRestaurantClosed
 	}
 
-	// Ensure total price is not negative after applying promo.
-	if totalPrice < 0 {
-		return entity.OrderResponse{}, fmt.Errorf("total price cannot be negative after applying promo")
+	// Stop new orders while ordering is paused or the kitchen is over capacity
+	if err := uc.checkKitchenCapacity(isScheduled); err != nil{
+		return entity.OrderResponse{}, err
 	}
 
-	payload.TotalPrice = totalPrice
-	payload.OrderStatus = "preparing"
-	payload.Date = time.Now()
-
-	// Validate the fields provided in the payload
-	if err := payload.Validate(); err != nil{
+	// Price the items, promo and delivery with the pricing service, so the order matches its quote
+	payload, err = uc.pricingUc.PriceOrder(payload)
+	if err != nil{
 		return entity.OrderResponse{}, err
 	}
 
-	// Get the customer's balance
-	balance, err := uc.balanceRepo.GetUserBalance(payload.CustomerId)
-	if err != nil || balance < 0 {
-		return entity.OrderResponse{}, fmt.Errorf("failed to get balance")
+	payload.OrderStatus = "confirmed"
+	payload.Date = time.Now()
+	historyNote := "order placed"
+
+	// Scheduled orders wait outside the kitchen queue until their delivery time comes near
+	if isScheduled{
+		payload.OrderStatus = "scheduled"
+		payload.Date = payload.ScheduledAt.In(schedule.Location)
+		historyNote = "order scheduled"
 	}
 
-	// Ensure the total price is less than customer's balance
-	if payload.TotalPrice > balance{
-		return entity.OrderResponse{}, fmt.Errorf("insufficient balance to complete order")
+	// Validate the fields provided in the payload
+	if err := payload.Validate(); err != nil{
+		return entity.OrderResponse{}, err
 	}
 
 	// Build a description of ordered items, joining each with commas and "and" for the last item.
@@ -97,116 +109,514 @@ func (uc *orderUseCase) CreateNewOrder(payload entity.Order) (entity.OrderRespon
 	}
 	}
 
-	// Set up the balance deduction entry with details of the transaction.
-	balancePayload := entity.Balance{
-		CustomerId:      payload.CustomerId,
-		TransactionType: "debit",
-		Amount:          payload.TotalPrice,
-		Description: "buy " + description,
+	// Debit the wallet, create the order and mark the promo in one transaction,
+	// so a failure at any step leaves the customer's balance untouched.
+	var order entity.OrderResponse
+	err = uc.txManager.WithTransaction(func(repos repository.TxRepositories) error {
+		// Get and lock the customer's balance until the transaction ends
+		balance, err := repos.Balance.LockUserBalance(payload.CustomerId)
+		if err != nil || balance < 0 {
+			return fmt.Errorf("failed to get balance")
+		}
+
+		// Ensure the total price and the tip are less than customer's balance
+		if payload.TotalPrice + payload.TipAmount > balance{
+			return config.ErrInsufficientBalance
+		}
+
+		// Enforce the active order policy while the balance lock serializes the customer's orders.
+		// Scheduled orders don't count until they are released to the kitchen.
+		if !isScheduled{
+			if err := uc.checkActiveOrderLimit(repos.Order, payload.CustomerId, payload.AddressId); err != nil{
+				return err
+			}
+		}
+
+		// Set up the balance deduction entry with details of the transaction.
+		balancePayload := entity.Balance{
+			CustomerId:      payload.CustomerId,
+			TransactionType: "debit",
+			Amount:          payload.TotalPrice,
+			Description: "buy " + description,
+		}
+		
+		// Adjust customer's balance by subtracting the order total.
+		balancePayload.Balance = balance - balancePayload.Amount
+		if err := repos.Balance.UpdateUserBalance(payload.CustomerId, balancePayload.Balance); err != nil{
+			return err
+		}
+
+		// Insert the value from balancePayload into balances
+		if _, err := repos.Balance.CreateBalance(balancePayload); err != nil{
+			return fmt.Errorf("failed to buy this order: %v", err.Error())
+		}
+
+		// Take the ordered quantities off the menu stock, rejecting the order when not enough is left.
+		// Scheduled orders are served from the stock of the day they are released on instead.
+		if !isScheduled{
+			if err := reserveStock(repos.Menu, payload.OrderItems); err != nil{
+				return err
+			}
+		}
+
+		// Insert the value into orders
+		order, err = repos.Order.CreateOrder(payload)
+		if err != nil {
+			return fmt.Errorf("failed to create order: %v", err)
+		}
+
+		// Update promo_used to True 
+		if payload.PromoCode != "" {
+			if err := repos.Promo.MarkPromoAsUsed(order.Id); err != nil {
+				return fmt.Errorf("failed to mark promo as used: %v", err)
+			}
+		}
+
+		// Debit the tip given at checkout as its own balance entry
+		if payload.TipAmount > 0 {
+			if err := uc.chargeTip(repos, order.Id, payload.CustomerId, balancePayload.Balance, payload.TipAmount); err != nil {
+				return err
+			}
+			order.TipAmount = payload.TipAmount
+		}
+
+		// Record the first stage of the order timeline
+		_, err = repos.Order.CreateOrderStatusHistory(entity.OrderStatusHistory{
+			OrderId: order.Id,
+			ToStatus: payload.OrderStatus,
+			ChangedBy: payload.CustomerId,
+			Note: historyNote,
+		})
+		return err
+	})
+	if err != nil{
+		return entity.OrderResponse{}, err
 	}
-	
-	// Adjust customer's balance by subtracting the order total.
-	balancePayload.Balance = balance - balancePayload.Amount
-	
 
-	// Insert the value from balancePayload into balances
-	_, err = uc.balanceRepo.CreateBalance(balancePayload)
+	order.Date = payload.Date.Format("January 02, 2006 03:04 PM")
+
+	// Push the new order to the customer and into the kitchen queue
+	uc.publishOrderEvent(payload.CustomerId, "order_placed", order)
+
+	return order, nil
+}
+
+func (uc *orderUseCase) QuoteOrder(payload entity.Order) (entity.PriceQuote, error){
+	return uc.pricingUc.QuoteOrder(payload)
+}
+
+func (uc *orderUseCase) GetUnfinishCustomerOrders(customerId string) ([]entity.OrderResponse, error){
+	orders, err := uc.repo.GetUnfinishOrdersbyCustomerId(customerId)
 	if err != nil{
-		return entity.OrderResponse{}, fmt.Errorf("failed to buy this order: %v", err.Error())
+		return nil, err
 	}
 
-	// Insert the value into orders
-	order, err := uc.repo.CreateOrder(payload)
-	if err != nil {
-			return entity.OrderResponse{}, fmt.Errorf("failed to create order: %v", err)
+	// Orders still in the kitchen are ready once the queue reaches them
+	queue, err := uc.GetKitchenQueue()
+	if err != nil{
+		return nil, err
+	}
+	readyAt := make(map[string]time.Time)
+	for _, order := range queue{
+		readyAt[order.Id] = order.ReadyAt
 	}
 
-	order.Date = time.Now().Format("January 02, 2006 03:04 PM")
+	// Estimate the delivery time from the ready time plus the courier's travel time
+	now := time.Now()
+	for i := range orders{
+		travelTime := time.Duration(orders[i].DeliveryDistance * uc.orderCfg.DeliveryMinutesPerKm * float64(time.Minute))
+
+		var estimatedAt time.Time
+		switch orders[i].OrderStatus{
+		case "confirmed", "preparing":
+			ready, ok := readyAt[orders[i].Id]
+			if !ok{
+				continue
+			}
+			estimatedAt = ready.Add(travelTime)
+		case "ready for pickup":
+			estimatedAt = now.Add(travelTime)
+		case "out for delivery":
+			// The courier has been on the way since the status changed
+			estimatedAt = now.Add(travelTime - orders[i].StatusAge)
+			if estimatedAt.Before(now){
+				estimatedAt = now
+			}
+		default:
+			// Scheduled orders show their scheduled time, failed deliveries have no estimate
+			continue
+		}
 
+		orders[i].EstimatedDeliveryAt = estimatedAt.Format("January 02, 2006 03:04 PM")
+	}
 
-	// Update promo_used to True 
-	if payload.PromoCode != "" {
-		err := uc.promoRepo.MarkPromoAsUsed(order.Id)
-		if err != nil {
-			return entity.OrderResponse{}, fmt.Errorf("failed to mark promo as used: %v", err)
+	return orders, nil
+}
+
+func (uc *orderUseCase) GetKitchenQueue() ([]entity.KitchenQueueOrder, error){
+	queue, err := uc.repo.GetKitchenQueue()
+	if err != nil{
+		return nil, err
+	}
+
+	// The kitchen works through the queue one order at a time,
+	// so each order is ready after the orders ahead of it plus its own preparation time
+	readyAt := time.Now()
+	for i := range queue{
+		queue[i].Position = i + 1
+		queue[i].PreparationTime = queue[i].RemainingPreparationTime()
+
+		readyAt = readyAt.Add(time.Duration(queue[i].PreparationTime) * time.Minute)
+		queue[i].ReadyAt = readyAt
+		queue[i].ExpectedReadyAt = readyAt.Format("January 02, 2006 03:04 PM")
+	}
+
+	return queue, nil
+}
+
+func (uc *orderUseCase) GetKitchenCapacity() (entity.KitchenCapacity, error){
+	settings, err := uc.restaurantUc.GetSettings()
+	if err != nil{
+		return entity.KitchenCapacity{}, err
+	}
+	queue, err := uc.GetKitchenQueue()
+	if err != nil{
+		return entity.KitchenCapacity{}, err
+	}
+
+	capacity := entity.KitchenCapacity{
+		OrderingPaused: settings.OrderingPaused,
+		PauseReason: settings.PauseReason,
+		QueuedOrders: len(queue),
+		MaxOrders: uc.orderCfg.MaxKitchenOrders,
+		MaxMinutes: uc.orderCfg.MaxKitchenMinutes,
+	}
+
+	// The queue clears once its last order is ready
+	clearAt := time.Now()
+	for _, order := range queue{
+		capacity.QueuedMinutes += order.PreparationTime
+		clearAt = order.ReadyAt
+	}
+
+	// The kitchen is busy once either limit is reached, a limit of zero or below disables it
+	capacity.IsBusy = (capacity.MaxOrders > 0 && capacity.QueuedOrders >= capacity.MaxOrders) ||
+		(capacity.MaxMinutes > 0 && capacity.QueuedMinutes >= capacity.MaxMinutes)
+
+	// Offer the first scheduled slot the kitchen can take while it is busy
+	if capacity.IsBusy{
+		schedule, err := uc.restaurantUc.GetSchedule()
+		if err != nil{
+			return entity.KitchenCapacity{}, err
+		}
+		if slot, ok := uc.suggestScheduledSlot(schedule, clearAt); ok{
+			capacity.SuggestedSlot = slot.Format(time.RFC3339)
 		}
 	}
 
-	return order, nil
+	return capacity, nil
 }
 
-func (uc *orderUseCase) GetUnfinishCustomerOrder(customerId string) (entity.OrderResponse, error){
-	return uc.repo.GetUnfinishOrderbyCustomerId(customerId)
+func (uc *orderUseCase) SetOrderingPause(payload entity.RestaurantSettings) (entity.KitchenCapacity, error){
+	// A reason only makes sense while ordering is paused
+	if !payload.OrderingPaused{
+		payload.PauseReason = ""
+	}
+
+	if _, err := uc.restaurantUc.UpdateOrderingPause(payload); err != nil{
+		return entity.KitchenCapacity{}, err
+	}
+
+	return uc.GetKitchenCapacity()
 }
 
-func (uc *orderUseCase) UpdateOrderStatus(payload entity.OrderResponse) (entity.OrderResponse, error){
-	// Retrieve the current order by id
-	order, err := uc.repo.GetOrderById(payload.Id)
+func (uc *orderUseCase) checkKitchenCapacity(isScheduled bool) error{
+	capacity, err := uc.GetKitchenCapacity()
 	if err != nil{
-		return entity.OrderResponse{}, err
+		return err
+	}
+
+	// A manual pause stops every new order, scheduled ones included
+	if capacity.OrderingPaused{
+		if capacity.PauseReason != ""{
+			return fmt.Errorf("%w: %s", config.ErrOrderingPaused, capacity.PauseReason)
+		}
+		return config.ErrOrderingPaused
+	}
+
+	// Scheduled orders only reach the kitchen later, so only orders for now are turned away
+	if capacity.IsBusy && !isScheduled{
+		if capacity.SuggestedSlot != ""{
+			return fmt.Errorf("%w, schedule the order with scheduled_at %s or later", config.ErrRestaurantBusy, capacity.SuggestedSlot)
+		}
+		return config.ErrRestaurantBusy
+	}
+
+	return nil
+}
+
+// suggestScheduledSlot returns the first valid delivery time, on a quarter hour, whose release reaches the kitchen after clearAt.
+func (uc *orderUseCase) suggestScheduledSlot(schedule entity.OpeningSchedule, clearAt time.Time) (time.Time, bool){
+	const step = 15 * time.Minute
+
+	// Scheduled orders are released ReleaseBefore ahead of their delivery time
+	slot := clearAt.Add(uc.orderCfg.ReleaseBefore)
+	if earliest := time.Now().Add(uc.orderCfg.MinLeadTime); slot.Before(earliest){
+		slot = earliest
+	}
+	if rounded := slot.Truncate(step); rounded.Before(slot){
+		slot = rounded.Add(step)
 	}
 
-	// Update the order status to the next stage if applicable
-	if order.OrderStatus == "preparing"{
-		order.OrderStatus = "out for delivery"
-	} else if order.OrderStatus == "out for delivery"{
-		order.OrderStatus = "delivered"
-	} else{
-		return entity.OrderResponse{}, fmt.Errorf("can't update order")
+	// Move forward until the slot falls within the opening hours and the scheduling window
+	latest := time.Now().AddDate(0, 0, uc.orderCfg.MaxScheduleDays)
+	for ; !slot.After(latest); slot = slot.Add(step){
+		if uc.validateScheduledAt(schedule, slot) == nil{
+			return slot, true
+		}
 	}
 
-	return uc.repo.UpdateOrderStatus(order)
+	return time.Time{}, false
 }
 
-func (uc *orderUseCase) CalculateTotalPrice(payload entity.Order) (float64, error) {
-	var totalPrice float64 = 0
+func (uc *orderUseCase) MarkOrderItemDone(orderId, itemId string) (entity.OrderResponse, error){
+	if err := uc.repo.MarkOrderItemDone(orderId, itemId); err != nil{
+		return entity.OrderResponse{}, err
+	}
+
+	// Retrieve the updated order with its items
+	return uc.repo.GetOrderById(orderId)
+}
+
+func (uc *orderUseCase) UpdateOrderStatus(payload entity.OrderStatusHistory) (entity.OrderResponse, error){
+	return uc.updateOrderStatus(payload, func(order entity.Order) error {
+		return nil
+	})
+}
+
+func (uc *orderUseCase) UpdateDeliveryStatus(payload entity.OrderStatusHistory) (entity.OrderResponse, error){
+	return uc.updateOrderStatus(payload, func(order entity.Order) error {
+		// Ensure the delivery is assigned to the courier
+		if order.CourierId != payload.ChangedBy{
+			return fmt.Errorf("the order is not assigned to this courier")
+		}
+
+		return nil
+	})
+}
 
-	// Iterate the order_items
-	for _, item := range payload.OrderItems {
-			// Retrieve menu details by name
-			menu, err := uc.menuRepo.GetMenubyName(item.MenuName)
-			if err != nil {
-					return 0, fmt.Errorf("failed to retrieve menu details for item %s: %v", item.MenuName, err)
+func (uc *orderUseCase) updateOrderStatus(payload entity.OrderStatusHistory, canUpdate func(order entity.Order) error) (entity.OrderResponse, error){
+	// Move the order to its next status and record the transition in one transaction
+	var customerId string
+	err := uc.txManager.WithTransaction(func(repos repository.TxRepositories) error {
+		// Retrieve and lock the current order by id
+		order, err := repos.Order.GetOrderForUpdate(payload.OrderId)
+		if err != nil{
+			return err
+		}
+
+		if err := canUpdate(order); err != nil{
+			return err
+		}
+		customerId = order.CustomerId
+
+		// Default to the next stage when no target status is provided
+		if payload.ToStatus == ""{
+			next, ok := entity.NextOrderStatus(order.OrderStatus)
+			if !ok{
+				return fmt.Errorf("can't update order with status %s", order.OrderStatus)
 			}
+			payload.ToStatus = next
+		}
+
+		// Cancellation also refunds the customer, so it has its own flow
+		if payload.ToStatus == "cancelled"{
+			return fmt.Errorf("use the cancel endpoint to cancel an order")
+		}
+
+		// Ensure the transition is allowed by the order state machine
+		if !entity.CanTransitionOrderStatus(order.OrderStatus, payload.ToStatus){
+			return fmt.Errorf("can't update order status from %s to %s", order.OrderStatus, payload.ToStatus)
+		}
 
-			// Ensure price and quantity are valid
-			if menu.Price == 0 {
-					return 0, fmt.Errorf("menu with id %s has invalid price", item.MenuName)
+		if _, err := repos.Order.UpdateOrderStatus(entity.OrderResponse{Id: order.Id, OrderStatus: payload.ToStatus}); err != nil{
+			return err
+		}
+
+		// A released scheduled order takes its items off the stock now, rejecting the release when not enough is left
+		if order.OrderStatus == "scheduled"{
+			items, err := repos.Order.GetOrderStockItems(order.Id)
+			if err != nil{
+				return err
 			}
-			if item.Quantity <= 0 {
-					return 0, fmt.Errorf("invalid quantity for menu item %s", item.MenuName)
+			if err := reserveStock(repos.Menu, items); err != nil{
+				return err
 			}
+		}
 
-			// Calculate item total
-			itemTotal := menu.Price * float64(item.Quantity)
-			totalPrice += itemTotal
+		// A delivered order has used up the ingredients of its recipes
+		if payload.ToStatus == "delivered"{
+			if err := repos.Inventory.ConsumeOrderIngredients(order.Id, payload.ChangedBy, time.Now()); err != nil{
+				return err
+			}
+		}
+
+		// Record the transition in the order timeline
+		payload.FromStatus = order.OrderStatus
+		_, err = repos.Order.CreateOrderStatusHistory(payload)
+		return err
+	})
+	if err != nil{
+		return entity.OrderResponse{}, err
 	}
 
-	return totalPrice, nil
+	return uc.getOrderAndPublish(payload.OrderId, customerId, "order_status")
 }
 
-func (uc *orderUseCase) ApplyPromo(payload entity.Order) (entity.Promo, error) {
-	// Retrieve the current promo by promo_code
-	promo, err := uc.promoRepo.GetPromoByPromoCode(payload.PromoCode)
-	if err != nil {
-		return entity.Promo{}, err
+func (uc *orderUseCase) AssignCourier(id, courierId, employeeId string) (entity.OrderResponse, error){
+	var customerId string
+	err := uc.txManager.WithTransaction(func(repos repository.TxRepositories) error {
+		// Retrieve and lock the current order by id
+		order, err := repos.Order.GetOrderForUpdate(id)
+		if err != nil{
+			return err
+		}
+
+		// Only orders that are ready can be handed to a courier
+		if order.OrderStatus != "ready for pickup"{
+			return fmt.Errorf("only orders that are ready for pickup can be assigned to a courier")
+		}
+		customerId = order.CustomerId
+
+		// Let the system pick the least-loaded courier when none is given
+		if courierId == ""{
+			courierId, err = repos.Order.GetLeastLoadedCourier()
+			if err != nil{
+				return err
+			}
+		} else {
+			isCourier, err := repos.Order.IsCourier(courierId)
+			if err != nil{
+				return err
+			}
+			if !isCourier{
+				return fmt.Errorf("user with id %s is not a courier", courierId)
+			}
+		}
+
+		if err := repos.Order.AssignCourier(order.Id, courierId); err != nil{
+			return err
+		}
+
+		// Record the assignment in the order timeline
+		_, err = repos.Order.CreateOrderStatusHistory(entity.OrderStatusHistory{
+			OrderId: order.Id,
+			FromStatus: order.OrderStatus,
+			ToStatus: order.OrderStatus,
+			ChangedBy: employeeId,
+			Note: "courier assigned",
+		})
+		return err
+	})
+	if err != nil{
+		return entity.OrderResponse{}, err
 	}
 
-	// Check if the promo is currently active based on start and end dates.
-	if promo.StartDate.After(time.Now()) || promo.EndDate.Before(time.Now()) {
-		return entity.Promo{}, fmt.Errorf("promo code %s is not valid at this time", payload.PromoCode)
+	return uc.getOrderAndPublish(id, customerId, "courier_assigned")
+}
+
+func (uc *orderUseCase) ReleaseScheduledOrders() (int, error){
+	// Retrieve the scheduled orders whose delivery time is close enough to start preparing
+	ids, err := uc.repo.GetDueScheduledOrders(time.Now().UTC().Add(uc.orderCfg.ReleaseBefore))
+	if err != nil{
+		return 0, err
 	}
 
-	// Verify if the promo has already been used by this customer.
-	used, err := uc.promoRepo.IsPromoUsed(payload.CustomerId, payload.PromoCode)
-	if err != nil {
-		return entity.Promo{}, fmt.Errorf("failed to check promo usage usecase: %v", err)
+	// Move each order into the kitchen queue, keep going when one of them fails
+	released := 0
+	var releaseErr error
+	for _, id := range ids{
+		_, err := uc.updateOrderStatus(entity.OrderStatusHistory{
+			OrderId: id,
+			ToStatus: "confirmed",
+			Note: "scheduled order released to the kitchen",
+		}, func(order entity.Order) error {
+			// The order may have been cancelled since it was selected
+			if order.OrderStatus != "scheduled"{
+				return fmt.Errorf("order with id %s is no longer scheduled", order.Id)
+			}
+			return nil
+		})
+		if err != nil{
+			// The items sold out before the order was released, so cancel it and refund the customer
+			if errors.Is(err, config.ErrNotEnoughStock) || errors.Is(err, config.ErrMenuUnavailable){
+				_, err = uc.cancelOrder(id, "", "cancelled on release: " + err.Error(), func(order entity.Order) error {
+					if order.OrderStatus != "scheduled"{
+						return fmt.Errorf("order with id %s is no longer scheduled", order.Id)
+					}
+					return nil
+				})
+			}
+			if err != nil{
+				releaseErr = err
+			}
+			continue
+		}
+		released++
+	}
+
+	return released, releaseErr
+}
+
+func (uc *orderUseCase) checkActiveOrderLimit(repo repository.OrderRepository, customerId, addressId string) error{
+	// Limit the number of active orders for the customer
+	if uc.orderCfg.MaxActiveOrders > 0{
+		var count int
+		if err := repo.CountUnfishOrder(customerId, &count); err != nil{
+			return err
+		}
+		if count >= uc.orderCfg.MaxActiveOrders{
+			return fmt.Errorf("cannot place more than %d active orders at a time", uc.orderCfg.MaxActiveOrders)
+		}
+	}
+
+	// Limit the number of active orders going to the same saved address
+	if uc.orderCfg.MaxActiveOrdersPerAddress > 0 && addressId != ""{
+		var count int
+		if err := repo.CountUnfinishOrderByAddress(customerId, addressId, &count); err != nil{
+			return err
+		}
+		if count >= uc.orderCfg.MaxActiveOrdersPerAddress{
+			return fmt.Errorf("cannot place more than %d active orders to the same address", uc.orderCfg.MaxActiveOrdersPerAddress)
+		}
+	}
+
+	return nil
+}
+
+func (uc *orderUseCase) validateScheduledAt(schedule entity.OpeningSchedule, scheduledAt time.Time) error{
+	now := time.Now()
+
+	// Ensure the kitchen has enough time to prepare the order
+	if scheduledAt.Before(now.Add(uc.orderCfg.MinLeadTime)){
+		return fmt.Errorf("scheduled delivery time must be at least %d minutes from now", int(uc.orderCfg.MinLeadTime.Minutes()))
 	}
-	if used {
-		return entity.Promo{}, fmt.Errorf("promo code %s has already been used by this customer", payload.PromoCode)
+
+	// Ensure the order isn't scheduled too far ahead
+	if scheduledAt.After(now.AddDate(0, 0, uc.orderCfg.MaxScheduleDays)){
+		return fmt.Errorf("orders can only be scheduled up to %d days ahead", uc.orderCfg.MaxScheduleDays)
+	}
+
+	// Ensure the delivery time is within the opening hours and not on a closure
+	if isOpen, _ := schedule.IsOpenAt(scheduledAt); !isOpen{
+		return fmt.Errorf("restaurant is closed at the scheduled delivery time")
 	}
 
-	return promo, nil
+	return nil
+}
+
+func (uc *orderUseCase) GetCourierOrders(courierId string) ([]entity.OrderResponse, error){
+	return uc.repo.GetCourierOrders(courierId)
 }
 
 func (uc *orderUseCase) GetAllOrder(page, size int, status string) ([]entity.OrderResponse, model.Paging, error) {
@@ -217,7 +627,325 @@ func (uc *orderUseCase) GetOrderHistory(page, size int, startDate, endDate strin
 	return uc.repo.GetOrderHistory(page, size, startDate, endDate, customerId)
 }
 
+func (uc *orderUseCase) CancelCustomerOrder(id, customerId string) (entity.OrderResponse, error){
+	return uc.cancelOrder(id, customerId, "cancelled by customer", func(order entity.Order) error {
+		// Ensure the order belongs to the customer
+		if order.CustomerId != customerId{
+			return config.ErrOrderNotOwned
+		}
+
+		// Customers can only cancel before the kitchen has finished preparing the order
+		if order.OrderStatus != "scheduled" && order.OrderStatus != "confirmed" && order.OrderStatus != "preparing"{
+			return fmt.Errorf("%w, only orders that are still preparing can be cancelled", config.ErrOrderNotCancellable)
+		}
+
+		return nil
+	})
+}
+
+func (uc *orderUseCase) CancelOrderByEmployee(id, employeeId, reason string) (entity.OrderResponse, error){
+	if reason == ""{
+		return entity.OrderResponse{}, fmt.Errorf("cancel reason is required")
+	}
+
+	return uc.cancelOrder(id, employeeId, reason, func(order entity.Order) error {
+		// Employees can cancel at any stage until the order is finished
+		if !entity.CanTransitionOrderStatus(order.OrderStatus, "cancelled"){
+			return fmt.Errorf("%w, its status is %s", config.ErrOrderNotCancellable, order.OrderStatus)
+		}
+
+		return nil
+	})
+}
+
+func (uc *orderUseCase) cancelOrder(id, changedBy, reason string, canCancel func(order entity.Order) error) (entity.OrderResponse, error){
+	// Cancel the order and refund the wallet in one transaction
+	var customerId string
+	err := uc.txManager.WithTransaction(func(repos repository.TxRepositories) error {
+		// Retrieve and lock the order, so it can't be cancelled and refunded twice
+		order, err := repos.Order.GetOrderForUpdate(id)
+		if err != nil{
+			return err
+		}
+
+		if err := canCancel(order); err != nil{
+			return err
+		}
+		customerId = order.CustomerId
+
+		// Set the order status to cancelled and give the promo usage back
+		if err := repos.Order.CancelOrder(order.Id, reason); err != nil{
+			return err
+		}
+
+		// Put the items of the order back into the menu stock, scheduled orders haven't taken theirs yet
+		if order.OrderStatus != "scheduled"{
+			if err := repos.Menu.RestoreOrderStock(order.Id); err != nil{
+				return err
+			}
+		}
+
+		// Get and lock the customer's balance until the transaction ends
+		balance, err := repos.Balance.LockUserBalance(order.CustomerId)
+		if err != nil{
+			return fmt.Errorf("failed to get balance")
+		}
+
+		// Set up the refund entry for the cancelled order.
+		balancePayload := entity.Balance{
+			CustomerId:      order.CustomerId,
+			TransactionType: "credit",
+			Amount:          order.TotalPrice,
+			Description: fmt.Sprintf("refund for cancelled order %s", order.Id),
+			Balance: balance + order.TotalPrice,
+		}
+
+		// Adjust customer's balance by adding the refunded total.
+		if err := repos.Balance.UpdateUserBalance(order.CustomerId, balancePayload.Balance); err != nil{
+			return err
+		}
+
+		// Insert the value from balancePayload into balances
+		if _, err := repos.Balance.CreateBalance(balancePayload); err != nil{
+			return fmt.Errorf("failed to refund this order: %v", err.Error())
+		}
+
+		// Refund the tips of the order as their own balance entry
+		if order.TipAmount > 0{
+			tipRefund := entity.Balance{
+				CustomerId:      order.CustomerId,
+				TransactionType: "credit",
+				Amount:          order.TipAmount,
+				Description: fmt.Sprintf("refund tip for cancelled order %s", order.Id),
+				Balance: balancePayload.Balance + order.TipAmount,
+			}
+			if err := repos.Balance.UpdateUserBalance(order.CustomerId, tipRefund.Balance); err != nil{
+				return err
+			}
+			if _, err := repos.Balance.CreateBalance(tipRefund); err != nil{
+				return fmt.Errorf("failed to refund the tip: %v", err.Error())
+			}
+		}
+
+		// Record the cancellation in the order timeline
+		_, err = repos.Order.CreateOrderStatusHistory(entity.OrderStatusHistory{
+			OrderId: order.Id,
+			FromStatus: order.OrderStatus,
+			ToStatus: "cancelled",
+			ChangedBy: changedBy,
+			Note: reason,
+		})
+		return err
+	})
+	if err != nil{
+		return entity.OrderResponse{}, err
+	}
+
+	return uc.getOrderAndPublish(id, customerId, "order_cancelled")
+}
+
+func (uc *orderUseCase) TipOrder(id, customerId string, amount float64) (entity.OrderResponse, error){
+	if amount <= 0{
+		return entity.OrderResponse{}, fmt.Errorf("tip must be greater than zero")
+	}
+
+	// Lock the order and the wallet, then debit the tip in one transaction
+	err := uc.txManager.WithTransaction(func(repos repository.TxRepositories) error {
+		order, err := repos.Order.GetOrderForUpdate(id)
+		if err != nil{
+			return err
+		}
+
+		// Ensure the order belongs to the customer and wasn't cancelled
+		if order.CustomerId != customerId{
+			return fmt.Errorf("the customer doesn't belong to this order")
+		}
+		if order.OrderStatus == "cancelled"{
+			return fmt.Errorf("can't tip a cancelled order")
+		}
+
+		// Get and lock the customer's balance until the transaction ends
+		balance, err := repos.Balance.LockUserBalance(customerId)
+		if err != nil{
+			return fmt.Errorf("failed to get balance")
+		}
+		if amount > balance{
+			return config.ErrInsufficientBalance
+		}
+
+		return uc.chargeTip(repos, order.Id, customerId, balance, amount)
+	})
+	if err != nil{
+		return entity.OrderResponse{}, err
+	}
+
+	return uc.repo.GetOrderById(id)
+}
+
+func (uc *orderUseCase) Reorder(id, customerId string) (entity.ReorderResponse, error){
+	// Retrieve the past order with its items, it must belong to the customer
+	order, err := uc.repo.GetCustomerOrderForReorder(id, customerId)
+	if err != nil{
+		return entity.ReorderResponse{}, err
+	}
+
+	// Only delivered orders can be ordered again
+	if order.OrderStatus != "delivered"{
+		return entity.ReorderResponse{}, fmt.Errorf("only delivered orders can be reordered")
+	}
+	if order.AddressId == ""{
+		return entity.ReorderResponse{}, fmt.Errorf("the address of this order was deleted, place a new order instead")
+	}
+
+	// Skip the items that can't be ordered anymore and report the repriced ones
+	items, skipped, repriced, err := uc.pricingUc.RepriceOrderItems(order.OrderItems)
+	if err != nil{
+		return entity.ReorderResponse{}, err
+	}
+	if len(items) == 0{
+		return entity.ReorderResponse{}, fmt.Errorf("none of the items in this order are available anymore")
+	}
+
+	// Place the order through the regular checkout flow, priced at the current prices
+	resp, err := uc.CreateNewOrder(entity.Order{
+		CustomerId: customerId,
+		AddressId: order.AddressId,
+		Note: order.Note,
+		OrderItems: items,
+	})
+	if err != nil{
+		return entity.ReorderResponse{}, err
+	}
+
+	return entity.ReorderResponse{Order: resp, SkippedItems: skipped, RepricedItems: repriced}, nil
+}
+
+func (uc *orderUseCase) GetTipReport(startDate, endDate, groupBy string) (entity.TipReport, error){
+	// Default to daily totals of the current month
+	if groupBy == ""{
+		groupBy = "day"
+	}
+	if groupBy != "day" && groupBy != "week" && groupBy != "month"{
+		return entity.TipReport{}, fmt.Errorf("group_by must be day, week or month")
+	}
+
+	// Days are counted in the restaurant's timezone, not the server's
+	location := uc.restaurantCfg.Location
+	now := time.Now().In(location)
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, location)
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location)
+	var err error
+	if startDate != ""{
+		if start, err = time.ParseInLocation("2006-01-02", startDate, location); err != nil{
+			return entity.TipReport{}, fmt.Errorf("invalid start_date, use the YYYY-MM-DD format")
+		}
+	}
+	if endDate != ""{
+		if end, err = time.ParseInLocation("2006-01-02", endDate, location); err != nil{
+			return entity.TipReport{}, fmt.Errorf("invalid end_date, use the YYYY-MM-DD format")
+		}
+	}
+	if end.Before(start){
+		return entity.TipReport{}, fmt.Errorf("end_date can't be before start_date")
+	}
+
+	// The end date is inclusive, so the report runs until the start of the next day
+	periods, err := uc.repo.GetTipReport(start, end.AddDate(0, 0, 1), groupBy, location.String())
+	if err != nil{
+		return entity.TipReport{}, err
+	}
+
+	report := entity.TipReport{
+		StartDate: start.Format("2006-01-02"),
+		EndDate: end.Format("2006-01-02"),
+		GroupBy: groupBy,
+		Periods: periods,
+	}
+	for _, period := range periods{
+		report.TipCount += period.TipCount
+		report.TotalTips += period.TotalTips
+	}
+
+	return report, nil
+}
+
+func (uc *orderUseCase) chargeTip(repos repository.TxRepositories, orderId, customerId string, balance, amount float64) error{
+	// Set up the tip deduction entry, separate from the order payment
+	balancePayload := entity.Balance{
+		CustomerId:      customerId,
+		TransactionType: "debit",
+		Amount:          amount,
+		Description: fmt.Sprintf("tip for order %s", orderId),
+		Balance: balance - amount,
+	}
+
+	// Adjust customer's balance by subtracting the tip.
+	if err := repos.Balance.UpdateUserBalance(customerId, balancePayload.Balance); err != nil{
+		return err
+	}
+
+	// Insert the value from balancePayload into balances
+	if _, err := repos.Balance.CreateBalance(balancePayload); err != nil{
+		return fmt.Errorf("failed to debit the tip: %v", err.Error())
+	}
+
+	// Store the tip on the order
+	_, err := repos.Order.AddOrderTip(entity.OrderTip{OrderId: orderId, CustomerId: customerId, Amount: amount})
+	return err
+}
+
+// reserveStock takes the quantity of every ordered menu item off its stock. The menus are updated in a fixed order,
+// so two orders sharing items can't deadlock on each other's row locks.
+func reserveStock(menuRepo repository.MenuRepository, items []entity.OrderItem) error{
+	// The same menu can be ordered on several lines with different options
+	quantities := make(map[string]int)
+	menuIds := []string{}
+	for _, item := range items{
+		if _, ok := quantities[item.MenuId]; !ok{
+			menuIds = append(menuIds, item.MenuId)
+		}
+		quantities[item.MenuId] += item.Quantity
+	}
+	sort.Strings(menuIds)
+
+	for _, menuId := range menuIds{
+		if err := menuRepo.ReserveStock(menuId, quantities[menuId]); err != nil{
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (uc *orderUseCase) SubscribeCustomerOrders(customerId string, lastEventId uint64) (<-chan service.Event, []service.Event, func()){
+	return uc.eventHub.Subscribe(service.CustomerTopic(customerId), lastEventId)
+}
+
+func (uc *orderUseCase) SubscribeKitchenOrders(lastEventId uint64) (<-chan service.Event, []service.Event, func()){
+	return uc.eventHub.Subscribe(service.KitchenTopic, lastEventId)
+}
+
+func (uc *orderUseCase) getOrderAndPublish(id, customerId, eventType string) (entity.OrderResponse, error){
+	// Retrieve the updated order with its timeline
+	order, err := uc.repo.GetOrderById(id)
+	if err != nil{
+		return entity.OrderResponse{}, err
+	}
+
+	uc.publishOrderEvent(customerId, eventType, order)
+
+	return order, nil
+}
+
+func (uc *orderUseCase) publishOrderEvent(customerId, eventType string, order entity.OrderResponse){
+	// Stream the change to the customer who owns the order and to the kitchen,
+	// scheduled orders only reach the kitchen once they are released
+	uc.eventHub.Publish(service.CustomerTopic(customerId), eventType, order)
+	if order.OrderStatus != "scheduled"{
+		uc.eventHub.Publish(service.KitchenTopic, eventType, order)
+	}
+}
 
-func NewOrderUseCase(repo repository.OrderRepository, menuRepo repository.MenuRepository, balanceRepo repository.BalanceRepository, promoRepo repository.PromoRepository) OrderUseCase{
-	return &orderUseCase{repo: repo, menuRepo: menuRepo, balanceRepo: balanceRepo, promoRepo: promoRepo}
+func NewOrderUseCase(repo repository.OrderRepository, restaurantUc RestaurantUseCase, pricingUc PricingUseCase, txManager repository.TransactionManager, eventHub service.EventHub, orderCfg config.OrderConfig, restaurantCfg config.RestaurantConfig) OrderUseCase{
+	return &orderUseCase{repo: repo, restaurantUc: restaurantUc, pricingUc: pricingUc, txManager: txManager, eventHub: eventHub, orderCfg: orderCfg, restaurantCfg: restaurantCfg}
 }
\ No newline at end of file
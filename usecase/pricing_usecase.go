@@ -0,0 +1,300 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+	"food-delivery-apps/repository"
+	"math"
+	"time"
+)
+
+type pricingUseCase struct{
+	menuRepo repository.MenuRepository
+	promoRepo repository.PromoRepository
+	modifierRepo repository.ModifierRepository
+	balanceRepo repository.BalanceRepository
+	deliveryUc DeliveryUseCase
+	pricingCfg config.PricingConfig
+}
+
+type PricingUseCase interface{
+	QuoteOrder(payload entity.Order) (entity.PriceQuote, error)
+	PriceOrder(payload entity.Order) (entity.Order, error)
+	RepriceOrderItems(items []entity.OrderItem) ([]entity.OrderItem, []entity.ReorderItem, []entity.ReorderItem, error)
+}
+
+func (uc *pricingUseCase) QuoteOrder(payload entity.Order) (entity.PriceQuote, error){
+	// Price every item at the current menu price and get the subtotal from CalculateTotalPrice method
+	orderItems, subtotal, err := uc.CalculateTotalPrice(payload)
+	if err != nil{
+		return entity.PriceQuote{}, err
+	}
+
+	// Price the delivery to the customer's address from the zone covering it
+	delivery, err := uc.deliveryUc.GetDeliveryQuote(payload.CustomerId, payload.AddressId)
+	if err != nil{
+		return entity.PriceQuote{}, err
+	}
+
+	// Keep the food subtotal, discount and delivery fee as separate lines of the quote
+	quote := entity.PriceQuote{
+		OrderItems: orderItems,
+		Subtotal: subtotal,
+		PromoCode: payload.PromoCode,
+		DeliveryFee: delivery.DeliveryFee,
+		Delivery: delivery,
+	}
+
+	// Apply discount if a promo code is provided, keeping the reason it was applied or rejected.
+	if payload.PromoCode != ""{
+		promo, err := uc.ApplyPromo(payload)
+		if err != nil{
+			quote.PromoMessage = err.Error()
+		} else {
+			// Calculate the discount based on promo type (percentage or flat).
+			var discount float64
+			if promo.IsPercentage {
+				discount = (quote.Subtotal * promo.Discount) / 100
+				quote.PromoMessage = fmt.Sprintf("%.0f%% off the subtotal", promo.Discount)
+			} else {
+				discount = promo.Discount
+				quote.PromoMessage = fmt.Sprintf("%.2f off the subtotal", promo.Discount)
+			}
+
+			// Ensure total price is not negative after applying promo.
+			if discount > quote.Subtotal{
+				quote.PromoMessage = "total price cannot be negative after applying promo"
+			} else {
+				quote.PromoApplied = true
+				quote.DiscountAmount = discount
+			}
+		}
+	}
+
+	// Add the service charge and tax on the food, then the delivery fee on top
+	uc.applyTaxAndServiceCharge(&quote)
+	quote.TotalPrice = quote.Subtotal - quote.DiscountAmount + quote.ServiceCharge + quote.DeliveryFee
+	if !quote.TaxInclusive{
+		quote.TotalPrice += quote.TaxAmount
+	}
+	quote.TotalPrice = roundPrice(quote.TotalPrice)
+
+	// Show how much of the wallet is left after paying the order
+	balance, err := uc.balanceRepo.GetUserBalance(payload.CustomerId)
+	if err != nil{
+		return entity.PriceQuote{}, err
+	}
+	// The tip is debited on its own and isn't part of the order total
+	quote.TipAmount = payload.TipAmount
+	quote.Balance = balance
+	quote.RemainingBalance = balance - quote.TotalPrice - quote.TipAmount
+	quote.IsBalanceSufficient = quote.RemainingBalance >= 0
+
+	return quote, nil
+}
+
+func (uc *pricingUseCase) PriceOrder(payload entity.Order) (entity.Order, error){
+	// Price the order with the same quote customers preview
+	quote, err := uc.QuoteOrder(payload)
+	if err != nil{
+		return entity.Order{}, err
+	}
+
+	// A promo that was asked for but not applied fails the order instead of silently charging full price
+	if payload.PromoCode != "" && !quote.PromoApplied{
+		return entity.Order{}, errors.New(quote.PromoMessage)
+	}
+
+	payload.OrderItems = quote.OrderItems
+	payload.Address = quote.Delivery.Address
+	payload.DeliveryDistance = quote.Delivery.DistanceKm
+	payload.Subtotal = quote.Subtotal
+	payload.DiscountAmount = quote.DiscountAmount
+	payload.DeliveryFee = quote.DeliveryFee
+	payload.ServiceCharge = quote.ServiceCharge
+	payload.TaxAmount = quote.TaxAmount
+	payload.TaxInclusive = quote.TaxInclusive
+	payload.TotalPrice = quote.TotalPrice
+
+	return payload, nil
+}
+
+// RepriceOrderItems checks past order items against the current menus. It returns the items that can still be ordered,
+// the items skipped because their menu or options are gone, and the items whose unit price changed since.
+func (uc *pricingUseCase) RepriceOrderItems(items []entity.OrderItem) ([]entity.OrderItem, []entity.ReorderItem, []entity.ReorderItem, error){
+	// Retrieve the live menus and modifier groups of every item
+	menuIds := make([]string, 0, len(items))
+	for _, item := range items{
+		menuIds = append(menuIds, item.MenuId)
+	}
+	menus, err := uc.menuRepo.GetMenusbyIds(menuIds)
+	if err != nil{
+		return nil, nil, nil, err
+	}
+	groups, err := uc.modifierRepo.GetModifierGroupsByMenuIds(menuIds)
+	if err != nil{
+		return nil, nil, nil, err
+	}
+
+	available := []entity.OrderItem{}
+	skipped := []entity.ReorderItem{}
+	repriced := []entity.ReorderItem{}
+	for _, item := range items{
+		line := entity.ReorderItem{MenuName: item.MenuName, Quantity: item.Quantity, PreviousUnitPrice: item.UnitPrice}
+
+		// Archived, unavailable or sold out menus and options that no longer exist can't be ordered again
+		menu, ok := menus[item.MenuId]
+		if !ok{
+			line.Reason = "menu is no longer available"
+			skipped = append(skipped, line)
+			continue
+		}
+		if !menu.IsAvailable || (menu.StockLeft != nil && *menu.StockLeft == 0){
+			line.Reason = "menu is unavailable or sold out today"
+			skipped = append(skipped, line)
+			continue
+		}
+		options, err := entity.SelectModifierOptions(groups[item.MenuId], item.OptionIds)
+		if err != nil{
+			line.Reason = fmt.Sprintf("options are no longer available: %v", err.Error())
+			skipped = append(skipped, line)
+			continue
+		}
+
+		// Compare the current unit price with the price paid last time
+		unitPrice := menu.Price
+		for _, option := range options{
+			unitPrice += option.PriceDelta
+		}
+		if unitPrice != item.UnitPrice{
+			line.MenuName = menu.Name
+			line.UnitPrice = unitPrice
+			repriced = append(repriced, line)
+		}
+
+		// Order the item again by its current name, the order is priced again at checkout
+		available = append(available, entity.OrderItem{MenuName: menu.Name, Quantity: item.Quantity, OptionIds: item.OptionIds})
+	}
+
+	return available, skipped, repriced, nil
+}
+
+func (uc *pricingUseCase) applyTaxAndServiceCharge(quote *entity.PriceQuote){
+	// The service charge is a share of the food after the promo discount
+	discounted := quote.Subtotal - quote.DiscountAmount
+	quote.ServiceCharge = roundPrice(discounted * uc.pricingCfg.ServiceChargeRate / 100)
+
+	// The tax covers the food, before or after the discount, and the service charge
+	taxBase := quote.Subtotal + quote.ServiceCharge
+	if uc.pricingCfg.TaxAfterDiscount{
+		taxBase = discounted + quote.ServiceCharge
+	}
+
+	// Exclusive tax is added on top, inclusive tax is the share already inside the prices
+	quote.TaxInclusive = uc.pricingCfg.TaxInclusive
+	if uc.pricingCfg.TaxInclusive{
+		quote.TaxAmount = roundPrice(taxBase - taxBase/(1+uc.pricingCfg.TaxRate/100))
+	} else {
+		quote.TaxAmount = roundPrice(taxBase * uc.pricingCfg.TaxRate / 100)
+	}
+}
+
+func (uc *pricingUseCase) CalculateTotalPrice(payload entity.Order) ([]entity.OrderItem, float64, error) {
+	// An order without items would only be charged the delivery fee
+	if len(payload.OrderItems) == 0 {
+		return nil, 0, config.ErrEmptyOrder
+	}
+
+	var totalPrice float64 = 0
+	orderItems := make([]entity.OrderItem, 0, len(payload.OrderItems))
+
+	// Iterate the order_items
+	for _, item := range payload.OrderItems {
+			// Retrieve menu details by name
+			menu, err := uc.menuRepo.GetMenubyName(item.MenuName)
+			if err != nil {
+					return nil, 0, fmt.Errorf("failed to retrieve menu details for item %s: %v", item.MenuName, err)
+			}
+
+			// Ensure the menu item can be ordered and enough of it is left
+			if !menu.IsAvailable || (menu.StockLeft != nil && item.Quantity > *menu.StockLeft) {
+					return nil, 0, entity.StockError(menu.Name, menu.IsAvailable, menu.StockLeft)
+			}
+
+			// Ensure price and quantity are valid
+			if menu.Price == 0 {
+					return nil, 0, fmt.Errorf("menu with id %s has invalid price", item.MenuName)
+			}
+			if item.Quantity <= 0 {
+					return nil, 0, fmt.Errorf("invalid quantity for menu item %s", item.MenuName)
+			}
+
+			// Check the selected options against the menu's modifier groups
+			groups, err := uc.modifierRepo.GetModifierGroupsByMenuIds([]string{menu.Id})
+			if err != nil {
+					return nil, 0, err
+			}
+			options, err := entity.SelectModifierOptions(groups[menu.Id], item.OptionIds)
+			if err != nil {
+					return nil, 0, fmt.Errorf("invalid options for menu item %s: %v", item.MenuName, err)
+			}
+
+			// The unit price is the menu price plus the price of every chosen option
+			unitPrice := menu.Price
+			for _, option := range options {
+					unitPrice += option.PriceDelta
+			}
+			if unitPrice <= 0 {
+					return nil, 0, fmt.Errorf("menu item %s has invalid price with the chosen options", item.MenuName)
+			}
+
+			// Snapshot the menu, options and current price on the item, then calculate the line total
+			item.MenuId = menu.Id
+			item.MenuName = menu.Name
+			item.OptionIds = nil
+			item.Options = options
+			item.UnitPrice = unitPrice
+			item.LineTotal = unitPrice * float64(item.Quantity)
+			item.PreparationTime = menu.PreparationTime
+			totalPrice += item.LineTotal
+
+			orderItems = append(orderItems, item)
+	}
+
+	return orderItems, totalPrice, nil
+}
+
+func (uc *pricingUseCase) ApplyPromo(payload entity.Order) (entity.Promo, error) {
+	// Retrieve the current promo by promo_code
+	promo, err := uc.promoRepo.GetPromoByPromoCode(payload.PromoCode)
+	if err != nil {
+		return entity.Promo{}, err
+	}
+
+	// Check if the promo is currently active based on start and end dates.
+	if promo.StartDate.After(time.Now()) || promo.EndDate.Before(time.Now()) {
+		return entity.Promo{}, fmt.Errorf("promo code %s is not valid at this time", payload.PromoCode)
+	}
+
+	// Verify if the promo has already been used by this customer.
+	used, err := uc.promoRepo.IsPromoUsed(payload.CustomerId, payload.PromoCode)
+	if err != nil {
+		return entity.Promo{}, fmt.Errorf("failed to check promo usage usecase: %v", err)
+	}
+	if used {
+		return entity.Promo{}, fmt.Errorf("promo code %s has already been used by this customer", payload.PromoCode)
+	}
+
+	return promo, nil
+}
+
+func NewPricingUseCase(menuRepo repository.MenuRepository, promoRepo repository.PromoRepository, modifierRepo repository.ModifierRepository, balanceRepo repository.BalanceRepository, deliveryUc DeliveryUseCase, pricingCfg config.PricingConfig) PricingUseCase{
+	return &pricingUseCase{menuRepo: menuRepo, promoRepo: promoRepo, modifierRepo: modifierRepo, balanceRepo: balanceRepo, deliveryUc: deliveryUc, pricingCfg: pricingCfg}
+}
+
+// roundPrice rounds an amount to two decimals.
+func roundPrice(amount float64) float64{
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+	"food-delivery-apps/repository"
+	"time"
+)
+
+type restaurantUseCase struct{
+	repo repository.RestaurantRepository
+	restaurantCfg config.RestaurantConfig
+}
+
+type RestaurantUseCase interface{
+	GetSettings() (entity.RestaurantSettings, error)
+	UpdateOrderingPause(payload entity.RestaurantSettings) (entity.RestaurantSettings, error)
+	CreateOpeningHour(payload entity.OpeningHour) (entity.OpeningHour, error)
+	GetOpeningHours() ([]entity.OpeningHour, error)
+	DeleteOpeningHour(id string) error
+	CreateClosure(payload entity.RestaurantClosure) (entity.RestaurantClosure, error)
+	GetClosures() ([]entity.RestaurantClosure, error)
+	DeleteClosure(id string) error
+	GetSchedule() (entity.OpeningSchedule, error)
+	GetStoreStatus() (entity.StoreStatus, error)
+}
+
+func (uc *restaurantUseCase) GetSettings() (entity.RestaurantSettings, error){
+	return uc.repo.GetSettings()
+}
+
+func (uc *restaurantUseCase) UpdateOrderingPause(payload entity.RestaurantSettings) (entity.RestaurantSettings, error){
+	return uc.repo.UpdateOrderingPause(payload)
+}
+
+func (uc *restaurantUseCase) CreateOpeningHour(payload entity.OpeningHour) (entity.OpeningHour, error){
+	// Validate the fields provided in the payload
+	if err := payload.Validate(); err != nil{
+		return entity.OpeningHour{}, err
+	}
+
+	return uc.repo.CreateOpeningHour(payload)
+}
+
+func (uc *restaurantUseCase) GetOpeningHours() ([]entity.OpeningHour, error){
+	return uc.repo.GetOpeningHours()
+}
+
+func (uc *restaurantUseCase) DeleteOpeningHour(id string) error{
+	return uc.repo.DeleteOpeningHour(id)
+}
+
+func (uc *restaurantUseCase) CreateClosure(payload entity.RestaurantClosure) (entity.RestaurantClosure, error){
+	// Validate the fields provided in the payload
+	if err := payload.Validate(); err != nil{
+		return entity.RestaurantClosure{}, err
+	}
+
+	return uc.repo.CreateClosure(payload)
+}
+
+func (uc *restaurantUseCase) GetClosures() ([]entity.RestaurantClosure, error){
+	// Only today's and upcoming closures are still relevant
+	return uc.repo.GetClosures(time.Now().In(uc.restaurantCfg.Location).Format("2006-01-02"))
+}
+
+func (uc *restaurantUseCase) DeleteClosure(id string) error{
+	return uc.repo.DeleteClosure(id)
+}
+
+func (uc *restaurantUseCase) GetSchedule() (entity.OpeningSchedule, error){
+	hours, err := uc.repo.GetOpeningHours()
+	if err != nil{
+		return entity.OpeningSchedule{}, err
+	}
+
+	// Include yesterday's closure, its holiday hours may run past midnight
+	closures, err := uc.repo.GetClosures(time.Now().In(uc.restaurantCfg.Location).AddDate(0, 0, -1).Format("2006-01-02"))
+	if err != nil{
+		return entity.OpeningSchedule{}, err
+	}
+
+	return entity.OpeningSchedule{Hours: hours, Closures: closures, Location: uc.restaurantCfg.Location}, nil
+}
+
+func (uc *restaurantUseCase) GetStoreStatus() (entity.StoreStatus, error){
+	settings, err := uc.repo.GetSettings()
+	if err != nil{
+		return entity.StoreStatus{}, err
+	}
+	schedule, err := uc.GetSchedule()
+	if err != nil{
+		return entity.StoreStatus{}, err
+	}
+
+	now := time.Now().In(uc.restaurantCfg.Location)
+	status := entity.StoreStatus{
+		OrderingPaused: settings.OrderingPaused,
+		PauseReason: settings.PauseReason,
+		Timezone: uc.restaurantCfg.Location.String(),
+	}
+
+	// Show when the current opening period ends, or when the restaurant opens next
+	isOpen, closesAt := schedule.IsOpenAt(now)
+	if isOpen{
+		status.ClosesAt = closesAt.Format(time.RFC3339)
+	} else if nextOpening, ok := schedule.NextOpening(now); ok{
+		status.NextOpeningAt = nextOpening.Format(time.RFC3339)
+	}
+	if closure, ok := schedule.ClosureOn(now); ok{
+		status.ClosureReason = closure.Reason
+	}
+
+	// A paused restaurant is closed for ordering until it is resumed
+	status.IsOpen = isOpen && !settings.OrderingPaused
+
+	return status, nil
+}
+
+func NewRestaurantUseCase(repo repository.RestaurantRepository, restaurantCfg config.RestaurantConfig) RestaurantUseCase{
+	return &restaurantUseCase{repo: repo, restaurantCfg: restaurantCfg}
+}
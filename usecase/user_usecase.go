@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"fmt"
+	"food-delivery-apps/config"
 	"food-delivery-apps/entity"
 	"food-delivery-apps/repository"
 	"food-delivery-apps/shared/model"
@@ -18,7 +19,7 @@ type UserUseCase interface {
 	CreateNewUser(payload entity.User) (entity.UserResponse, error)
 	FindUserByEmailPassword(email, password string) (entity.User, error)
 	GetAllUser(page, size int, role string) ([]entity.GetUserResponse, model.Paging, error)
-	AssignToEmployee(id string) (entity.UserResponse, error)
+	AssignRole(id, role string) (entity.UserResponse, error)
 	UpdateUser(payload entity.User) (entity.UserResponse, error)
 	DeleteUser(id string) error
 	Logout(token string) error
@@ -71,7 +72,12 @@ func (uc *userUseCase) GetAllUser(page, size int, role string) ([]entity.GetUser
 	return uc.repo.GetAllUser(page, size, role)
 }
 
-func (uc *userUseCase) AssignToEmployee(id string) (entity.UserResponse, error){
+func (uc *userUseCase) AssignRole(id, role string) (entity.UserResponse, error){
+	// Ensure the given role can be assigned by admin
+	if role != "employee" && role != "courier"{
+		return entity.UserResponse{}, config.ErrInvalidRole
+	}
+
 	// Retrieve the current user by id
 	user, err := uc.repo.GetUserbyId(id)
 	if err != nil {
@@ -80,12 +86,12 @@ func (uc *userUseCase) AssignToEmployee(id string) (entity.UserResponse, error){
 
 	// assign user's role into given role
 	if user.Role == "admin"{
-		return entity.UserResponse{}, fmt.Errorf("admins can't be update to employee")
+		return entity.UserResponse{}, fmt.Errorf("admins can't be update to %s", role)
 	}
-	if user.Role == "employee"{
-		return entity.UserResponse{}, fmt.Errorf("user role already update to employee")
+	if user.Role == role{
+		return entity.UserResponse{}, fmt.Errorf("user role already update to %s", role)
 	}
-	user.Role = "employee"
+	user.Role = role
 
 	user.UpdatedAt = time.Now().Format("January 02, 2006 03:04 PM")
 
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"food-delivery-apps/config"
+	"food-delivery-apps/entity"
+	"food-delivery-apps/repository"
+	"food-delivery-apps/shared/service"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+)
+
+// openRestaurant keeps the restaurant open and unpaused, so only the wallet decides whether an order goes through
+type openRestaurant struct{
+	RestaurantUseCase
+}
+
+func (openRestaurant) GetSchedule() (entity.OpeningSchedule, error){
+	return entity.OpeningSchedule{Location: time.UTC}, nil
+}
+
+func (openRestaurant) GetSettings() (entity.RestaurantSettings, error){
+	return entity.RestaurantSettings{}, nil
+}
+
+// fixedPricing prices every order as one item of the test menu, without an address or delivery zone
+type fixedPricing struct{
+	PricingUseCase
+	menu entity.MenuResponse
+}
+
+func (p fixedPricing) PriceOrder(payload entity.Order) (entity.Order, error){
+	payload.OrderItems = []entity.OrderItem{{
+		MenuId: p.menu.Id,
+		MenuName: p.menu.Name,
+		Quantity: 1,
+		UnitPrice: p.menu.Price,
+		LineTotal: p.menu.Price,
+	}}
+	payload.Address = "concurrency test address"
+	payload.Subtotal = p.menu.Price
+	payload.TotalPrice = p.menu.Price
+
+	return payload, nil
+}
+
+// TestConcurrentOrdersAndTopUps fires parallel orders and top-ups at one customer and checks that the wallet
+// matches the ledger afterwards. It needs a database with assets/food_delivery_db.sql applied, for example
+// TEST_DATABASE_URL="host=localhost port=5432 user=postgres password=secret dbname=food_delivery_test sslmode=disable".
+func TestConcurrentOrdersAndTopUps(t *testing.T){
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == ""{
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil{
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	// Seed a customer, and a menu item without a daily stock so stock never limits the orders
+	suffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	var customerId, categoryId, unitTypeId string
+	if err := db.QueryRow(`INSERT INTO users(username, email, password, role, gender) VALUES($1, $2, 'x', 'customer', 'male') RETURNING id`,
+		"wallet-"+suffix, "wallet-"+suffix+"@test.local").Scan(&customerId); err != nil{
+		t.Fatalf("failed to seed customer: %v", err)
+	}
+	if err := db.QueryRow(`INSERT INTO menu_categories(name) VALUES($1) RETURNING id`, "wallet-"+suffix).Scan(&categoryId); err != nil{
+		t.Fatalf("failed to seed menu category: %v", err)
+	}
+	if err := db.QueryRow(`INSERT INTO unit_types(name) VALUES($1) RETURNING id`, "wallet-"+suffix).Scan(&unitTypeId); err != nil{
+		t.Fatalf("failed to seed unit type: %v", err)
+	}
+	menu, err := repository.NewMenuRepository(db).AddMenu(entity.Menu{
+		Name: "wallet-" + suffix,
+		CategoryId: categoryId,
+		Desc: "wallet-" + suffix,
+		UnitTypeId: unitTypeId,
+		Price: 10000,
+		CreatedBy: customerId,
+		UpdatedAt: time.Now(),
+	})
+	if err != nil{
+		t.Fatalf("failed to seed menu: %v", err)
+	}
+
+	// Remove the seeded rows again, orders first since order items keep their menu
+	defer func(){
+		db.Exec(`DELETE FROM orders WHERE customer_id = $1`, customerId)
+		db.Exec(`DELETE FROM menus WHERE id = $1`, menu.Id)
+		db.Exec(`DELETE FROM menu_categories WHERE id = $1`, categoryId)
+		db.Exec(`DELETE FROM unit_types WHERE id = $1`, unitTypeId)
+		db.Exec(`DELETE FROM users WHERE id = $1`, customerId)
+	}()
+
+	txManager := repository.NewTransactionManager(db)
+	balanceUc := NewBalanceUseCase(repository.NewBalanceRepository(db), txManager)
+	orderUc := NewOrderUseCase(repository.NewOrderRepository(db), openRestaurant{}, fixedPricing{menu: menu}, txManager,
+		service.NewEventHub(config.EventHistorySize), config.OrderConfig{}, config.RestaurantConfig{Location: time.UTC})
+
+	// Start with enough for a few orders, so some orders succeed and later ones race the top-ups
+	if _, err := balanceUc.IncreaseBalance(entity.Balance{CustomerId: customerId, Amount: 30000, Description: "opening top-up"}); err != nil{
+		t.Fatalf("failed to top up: %v", err)
+	}
+
+	const workers = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*2)
+	for i := 0; i < workers; i++{
+		wg.Add(2)
+		go func(){
+			defer wg.Done()
+			_, err := orderUc.CreateNewOrder(entity.Order{CustomerId: customerId})
+			if err != nil && !errors.Is(err, config.ErrInsufficientBalance){
+				errs <- fmt.Errorf("order: %v", err)
+			}
+		}()
+		go func(){
+			defer wg.Done()
+			if _, err := balanceUc.IncreaseBalance(entity.Balance{CustomerId: customerId, Amount: 5000, Description: "top-up"}); err != nil{
+				errs <- fmt.Errorf("top-up: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs{
+		t.Error(err)
+	}
+
+	// The wallet must equal the ledger, and no running balance may have gone below zero
+	var walletBalance, ledgerBalance, lowestBalance float64
+	if err := db.QueryRow(`SELECT balance FROM wallets WHERE customer_id = $1`, customerId).Scan(&walletBalance); err != nil{
+		t.Fatalf("failed to read wallet: %v", err)
+	}
+	if err := db.QueryRow(`SELECT COALESCE(SUM(CASE WHEN transaction_type = 'credit' THEN amount ELSE -amount END), 0),
+		COALESCE(MIN(balance), 0) FROM balances WHERE customer_id = $1`, customerId).Scan(&ledgerBalance, &lowestBalance); err != nil{
+		t.Fatalf("failed to read ledger: %v", err)
+	}
+	if walletBalance != ledgerBalance{
+		t.Errorf("wallet balance %.2f doesn't match the ledger total %.2f", walletBalance, ledgerBalance)
+	}
+	if walletBalance < 0 || lowestBalance < 0{
+		t.Errorf("balance went negative: wallet %.2f, lowest running balance %.2f", walletBalance, lowestBalance)
+	}
+
+	// Every debit belongs to an order that was placed
+	var debits, orders int
+	db.QueryRow(`SELECT COUNT(*) FROM balances WHERE customer_id = $1 AND transaction_type = 'debit'`, customerId).Scan(&debits)
+	db.QueryRow(`SELECT COUNT(*) FROM orders WHERE customer_id = $1`, customerId).Scan(&orders)
+	if debits != orders{
+		t.Errorf("%d debits were written for %d orders", debits, orders)
+	}
+}